@@ -25,14 +25,16 @@
 
 package gomusicbrainz
 
+import "context"
+
 // Annotation is a miniature wiki that can be added to any existing artists,
 // labels, recordings, releases, release groups and works. More informations at
 // https://musicbrainz.org/doc/Annotation
 type Annotation struct {
-	Type   string `xml:"type,attr"`
-	Entity string `xml:"entity"`
-	Name   string `xml:"name"`
-	Text   string `xml:"text"`
+	Type   string `xml:"type,attr" json:"type,omitempty"`
+	Entity string `xml:"entity" json:"entity,omitempty"`
+	Name   string `xml:"name" json:"name,omitempty"`
+	Text   string `xml:"text" json:"text,omitempty"`
 }
 
 // SearchAnnotation queries MusicBrainz´ Search Server for Annotations.
@@ -46,10 +48,15 @@ type Annotation struct {
 //
 // For more information visit
 // http://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Annotation
-func (c *WS2Client) SearchAnnotation(searchTerm string, limit, offset int) (*AnnotationSearchResponse, error) {
+func (c *WS2Client) SearchAnnotation(searchTerm string, opts ...SearchOption) (*AnnotationSearchResponse, error) {
+	return c.SearchAnnotationContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchAnnotationContext is like SearchAnnotation but aborts the request once ctx is done.
+func (c *WS2Client) SearchAnnotationContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*AnnotationSearchResponse, error) {
 
 	result := annotationListResult{}
-	err := c.searchRequest("/annotation", &result, searchTerm, limit, offset)
+	err := c.searchRequest(ctx, "/annotation", &result, searchTerm, opts)
 
 	rsp := AnnotationSearchResponse{}
 	rsp.WS2ListResponse = result.AnnotationList.WS2ListResponse
@@ -67,8 +74,18 @@ func (c *WS2Client) SearchAnnotation(searchTerm string, limit, offset int) (*Ann
 // methods.
 type AnnotationSearchResponse struct {
 	WS2ListResponse
-	Annotations []*Annotation
-	Scores      ScoreMap
+	Annotations []*Annotation `json:"annotations,omitempty"`
+	Scores      ScoreMap      `json:"-"`
+}
+
+// HasMore reports whether there are annotations after the ones in r.
+func (r *AnnotationSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Annotations))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *AnnotationSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Annotations))
 }
 
 // ResultsWithScore returns a slice of Annotations with a min score.
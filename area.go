@@ -25,19 +25,43 @@
 
 package gomusicbrainz
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 // Area represents a geographic region or settlement.
 type Area struct {
-	ID            MBID           `xml:"id,attr"`
-	Type          string         `xml:"type,attr"`
-	Name          string         `xml:"name"`
-	SortName      string         `xml:"sort-name"`
-	ISO31662Codes []ISO31662Code `xml:"iso-3166-2-code-list>iso-3166-2-code"`
-	Lifespan      Lifespan       `xml:"life-span"`
-	Aliases       []Alias        `xml:"alias-list>alias"`
+	ID             MBID               `xml:"id,attr" json:"id,omitempty"`
+	Type           AreaType           `xml:"type,attr" json:"type,omitempty"`
+	Name           string             `xml:"name" json:"name,omitempty"`
+	SortName       string             `xml:"sort-name" json:"sortName,omitempty"`
+	Disambiguation string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	ISO31661Codes  []ISO31661Code     `xml:"iso-3166-1-code-list>iso-3166-1-code" json:"iso31661Codes,omitempty"`
+	ISO31662Codes  []ISO31662Code     `xml:"iso-3166-2-code-list>iso-3166-2-code" json:"iso31662Codes,omitempty"`
+	ISO31663Codes  []ISO31663Code     `xml:"iso-3166-3-code-list>iso-3166-3-code" json:"iso31663Codes,omitempty"`
+	Lifespan       Lifespan           `xml:"life-span" json:"lifespan,omitempty"`
+	Aliases        []Alias            `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Tags           []Tag              `xml:"tag-list>tag" json:"tags,omitempty"`
+	Genres         []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Annotation     string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations      TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
 }
 
+// AreaType describes what kind of geographic region an Area is. See
+// https://musicbrainz.org/doc/Area#Type
+type AreaType string
+
+const (
+	AreaTypeCountry      AreaType = "Country"
+	AreaTypeSubdivision  AreaType = "Subdivision"
+	AreaTypeCounty       AreaType = "County"
+	AreaTypeMunicipality AreaType = "Municipality"
+	AreaTypeCity         AreaType = "City"
+	AreaTypeDistrict     AreaType = "District"
+	AreaTypeIsland       AreaType = "Island"
+)
+
 func (mbe *Area) lookupResult() interface{} {
 	var res struct {
 		XMLName xml.Name `xml:"metadata"`
@@ -55,10 +79,23 @@ func (mbe *Area) Id() MBID {
 	return mbe.ID
 }
 
+// String returns the name, type and MBID of the area.
+func (mbe *Area) String() string {
+	return entityString(mbe.Name, mbe.ID, string(mbe.Type))
+}
+
 // LookupArea performs an area lookup request for the given MBID.
-func (c *WS2Client) LookupArea(id MBID, inc ...string) (*Area, error) {
+//
+// Possible inc params are aliases, tags, genres, annotation and <ENTITY>-rels
+// e.g. area-rels or url-rels.
+func (c *WS2Client) LookupArea(id MBID, inc ...IncludeOption) (*Area, error) {
+	return c.LookupAreaContext(context.Background(), id, inc...)
+}
+
+// LookupAreaContext is like LookupArea but aborts the request once ctx is done.
+func (c *WS2Client) LookupAreaContext(ctx context.Context, id MBID, inc ...IncludeOption) (*Area, error) {
 	a := &Area{ID: id}
-	err := c.Lookup(a, inc...)
+	err := c.LookupContext(ctx, a, inc...)
 
 	return a, err
 }
@@ -84,10 +121,15 @@ func (c *WS2Client) LookupArea(id MBID, inc ...string) (*Area, error) {
 // With no fields specified searchTerm searches the area and sortname fields.
 // For more information visit
 // http://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Area
-func (c *WS2Client) SearchArea(searchTerm string, limit, offset int) (*AreaSearchResponse, error) {
+func (c *WS2Client) SearchArea(searchTerm string, opts ...SearchOption) (*AreaSearchResponse, error) {
+	return c.SearchAreaContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchAreaContext is like SearchArea but aborts the request once ctx is done.
+func (c *WS2Client) SearchAreaContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*AreaSearchResponse, error) {
 
 	result := areaListResult{}
-	err := c.searchRequest("/area", &result, searchTerm, limit, offset)
+	err := c.searchRequest(ctx, "/area", &result, searchTerm, opts)
 
 	rsp := AreaSearchResponse{}
 	rsp.WS2ListResponse = result.AreaList.WS2ListResponse
@@ -104,8 +146,18 @@ func (c *WS2Client) SearchArea(searchTerm string, limit, offset int) (*AreaSearc
 // AreaSearchResponse is the response type returned by the SearchArea method.
 type AreaSearchResponse struct {
 	WS2ListResponse
-	Areas  []*Area
-	Scores ScoreMap
+	Areas  []*Area  `json:"areas,omitempty"`
+	Scores ScoreMap `json:"-"`
+}
+
+// HasMore reports whether there are areas after the ones in r.
+func (r *AreaSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Areas))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *AreaSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Areas))
 }
 
 // ResultsWithScore returns a slice of Areas with a min score.
@@ -40,7 +40,7 @@ func TestSearchArea(t *testing.T) {
 		Areas: []*Area{
 			{
 				ID:       "d79e4501-8cba-431b-96e7-bb9976f0ae76",
-				Type:     "Subdivision",
+				Type:     AreaTypeSubdivision,
 				Name:     "Île-de-France",
 				SortName: "Île-de-France",
 				ISO31662Codes: []ISO31662Code{
@@ -50,8 +50,26 @@ func TestSearchArea(t *testing.T) {
 					Ended: false,
 				},
 				Aliases: []Alias{
-					{Locale: "et", SortName: "Île-de-France", Type: "Area name", Primary: "primary", Name: "Île-de-France"},
-					{Locale: "ja", SortName: "イル＝ド＝フランス地域圏", Type: "Area name", Primary: "primary", Name: "イル＝ド＝フランス地域圏"},
+					{Locale: "et", SortName: "Île-de-France", Type: "Area name", Primary: true, Name: "Île-de-France"},
+					{Locale: "ja", SortName: "イル＝ド＝フランス地域圏", Type: "Area name", Primary: true, Name: "イル＝ド＝フランス地域圏"},
+				},
+				Relations: TargetRelationsMap{
+					"area": []Relation{
+						&AreaRelation{
+							RelationAbstract: RelationAbstract{
+								TypeID:    "de7cc874-8b1b-3a05-8272-f3834c968fb7",
+								Type:      "part of",
+								Target:    "08310658-51eb-3801-80de-5a0739207115",
+								Direction: "backward",
+							},
+							Area: Area{
+								ID:       "08310658-51eb-3801-80de-5a0739207115",
+								Type:     AreaTypeCountry,
+								Name:     "France",
+								SortName: "France",
+							},
+						},
+					},
 				},
 			},
 		},
@@ -61,7 +79,7 @@ func TestSearchArea(t *testing.T) {
 	defer server.Close()
 	serveTestFile("/area", "SearchArea.xml", t)
 
-	returned, err := client.SearchArea(`"Île-de-France"`, -1, -1)
+	returned, err := client.SearchArea(`"Île-de-France"`)
 	if err != nil {
 		t.Error(err)
 	}
@@ -74,3 +92,32 @@ func TestSearchArea(t *testing.T) {
 		t.Error(requestDiff(&want, returned))
 	}
 }
+
+func TestLookupArea(t *testing.T) {
+
+	want := Area{
+		ID:       "08310658-51eb-3801-80de-5a0739207115",
+		Type:     "Country",
+		Name:     "France",
+		SortName: "France",
+		ISO31661Codes: []ISO31661Code{
+			"FR",
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile(
+		"/area/08310658-51eb-3801-80de-5a0739207115",
+		"LookupArea.xml", t)
+
+	returned, err := client.LookupArea("08310658-51eb-3801-80de-5a0739207115")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
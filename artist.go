@@ -25,26 +25,65 @@
 
 package gomusicbrainz
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 // Artist represents generally a musician, a group of musicians, a collaboration
 // of multiple musicians or other music professionals.
 type Artist struct {
-	ID             MBID               `xml:"id,attr"`
-	Type           string             `xml:"type,attr"`
-	Name           string             `xml:"name"`
-	Disambiguation string             `xml:"disambiguation"`
-	SortName       string             `xml:"sort-name"`
-	CountryCode    string             `xml:"country"`
-	Gender         string             `xml:"gender"`
-	Lifespan       Lifespan           `xml:"life-span"`
-	Area           Area               `xml:"area"`
-	BeginArea      Area               `xml:"begin-area"`
-	Aliases        []*Alias           `xml:"alias-list>alias"`
-	Tags           []Tag              `xml:"tag-list>tag"`
-	Relations      TargetRelationsMap `xml:"relation-list"`
+	ID             MBID               `xml:"id,attr" json:"id,omitempty"`
+	Type           ArtistType         `xml:"type,attr" json:"type,omitempty"`
+	Name           string             `xml:"name" json:"name,omitempty"`
+	Disambiguation string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	SortName       string             `xml:"sort-name" json:"sortName,omitempty"`
+	CountryCode    string             `xml:"country" json:"countryCode,omitempty"`
+	Gender         Gender             `xml:"gender" json:"gender,omitempty"`
+	IPIs           []string           `xml:"ipi-list>ipi" json:"ipis,omitempty"`
+	ISNIs          []string           `xml:"isni-list>isni" json:"isnis,omitempty"`
+	Lifespan       Lifespan           `xml:"life-span" json:"lifespan,omitempty"`
+	Area           Area               `xml:"area" json:"area,omitempty"`
+	BeginArea      Area               `xml:"begin-area" json:"beginArea,omitempty"`
+	EndArea        Area               `xml:"end-area" json:"endArea,omitempty"`
+	Aliases        []*Alias           `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Tags           []Tag              `xml:"tag-list>tag" json:"tags,omitempty"`
+	Genres         []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Rating         Rating             `xml:"rating" json:"rating,omitempty"`
+	UserRating     UserRating         `xml:"user-rating" json:"userRating,omitempty"`
+	Annotation     string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations      TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
+	Recordings     []*Recording       `xml:"recording-list>recording" json:"recordings,omitempty"`
+	Releases       []*Release         `xml:"release-list>release" json:"releases,omitempty"`
+	ReleaseGroups  []*ReleaseGroup    `xml:"release-group-list>release-group" json:"releaseGroups,omitempty"`
+	Works          []*Work            `xml:"work-list>work" json:"works,omitempty"`
 }
 
+// ArtistType describes whether an artist is a person, a group or something
+// else. See https://musicbrainz.org/doc/Artist#Type
+type ArtistType string
+
+const (
+	ArtistTypePerson    ArtistType = "Person"
+	ArtistTypeGroup     ArtistType = "Group"
+	ArtistTypeOrchestra ArtistType = "Orchestra"
+	ArtistTypeChoir     ArtistType = "Choir"
+	ArtistTypeCharacter ArtistType = "Character"
+	ArtistTypeOther     ArtistType = "Other"
+)
+
+// Gender is the gender of an artist of type person or character. See
+// https://musicbrainz.org/doc/Artist#Gender
+type Gender string
+
+const (
+	GenderMale          Gender = "Male"
+	GenderFemale        Gender = "Female"
+	GenderNonBinary     Gender = "Non-binary"
+	GenderNotApplicable Gender = "Not applicable"
+	GenderOther         Gender = "Other"
+)
+
 func (mbe *Artist) lookupResult() interface{} {
 	var res struct {
 		XMLName xml.Name `xml:"metadata"`
@@ -62,10 +101,26 @@ func (mbe *Artist) Id() MBID {
 	return mbe.ID
 }
 
+// String returns the name, type, country and MBID of the artist e.g.
+// "Massive Attack [Group, GB] (MBID: 10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8)".
+func (mbe *Artist) String() string {
+	return entityString(mbe.Name, mbe.ID, string(mbe.Type), mbe.CountryCode)
+}
+
 // LookupArtist performs an artist lookup request for the given MBID.
-func (c *WS2Client) LookupArtist(id MBID, inc ...string) (*Artist, error) {
+//
+// Possible inc params are recordings, releases, release-groups, works,
+// aliases, tags, ratings, annotation, genres and <ENTITY>-rels e.g.
+// artist-rels. Recordings, Releases, ReleaseGroups and Works are only
+// populated if the corresponding inc param is given.
+func (c *WS2Client) LookupArtist(id MBID, inc ...IncludeOption) (*Artist, error) {
+	return c.LookupArtistContext(context.Background(), id, inc...)
+}
+
+// LookupArtistContext is like LookupArtist but aborts the request once ctx is done.
+func (c *WS2Client) LookupArtistContext(ctx context.Context, id MBID, inc ...IncludeOption) (*Artist, error) {
 	a := &Artist{ID: id}
-	err := c.Lookup(a, inc...)
+	err := c.LookupContext(ctx, a, inc...)
 
 	return a, err
 }
@@ -88,6 +143,7 @@ func (c *WS2Client) LookupArtist(id MBID, inc ...string) (*Artist, error) {
 //	ended         true if know ended even if do not know end date
 //	gender        gender of the artist (“male”, “female”, “other”)
 //	ipi           IPI code for the artist
+//	isni          ISNI code for the artist
 //	sortname      artist sortname
 //	tag           a tag applied to the artist
 //	type          artist type (“person”, “group”, "other" or “unknown”)
@@ -95,10 +151,15 @@ func (c *WS2Client) LookupArtist(id MBID, inc ...string) (*Artist, error) {
 // With no fields specified searchTerm searches the artist, sortname and alias
 // fields. For more information visit
 // http://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Artist
-func (c *WS2Client) SearchArtist(searchTerm string, limit, offset int) (*ArtistSearchResponse, error) {
+func (c *WS2Client) SearchArtist(searchTerm string, opts ...SearchOption) (*ArtistSearchResponse, error) {
+	return c.SearchArtistContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchArtistContext is like SearchArtist but aborts the request once ctx is done.
+func (c *WS2Client) SearchArtistContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*ArtistSearchResponse, error) {
 
 	result := artistListResult{}
-	err := c.searchRequest("/artist", &result, searchTerm, limit, offset)
+	err := c.searchRequest(ctx, "/artist", &result, searchTerm, opts)
 
 	rsp := ArtistSearchResponse{}
 	rsp.WS2ListResponse = result.ArtistList.WS2ListResponse
@@ -112,11 +173,49 @@ func (c *WS2Client) SearchArtist(searchTerm string, limit, offset int) (*ArtistS
 	return &rsp, err
 }
 
-// ArtistSearchResponse is the response type returned by the SearchArtist method.
+// BrowseArtists performs a browse request for Artists linked to the entity
+// with the given MBID. Possible linked entities are area, recording, release, release-group and work e.g.
+//
+//	client.BrowseArtists("release", "07832b54-8266-47d5-bb0e-62c7f2cf5da5", -1, -1, "aliases")
+//
+// Possible inc params are the same as for LookupArtist. Scores of the
+// returned response are not populated since browse requests are not ranked.
+func (c *WS2Client) BrowseArtists(entity string, id MBID, limit, offset int, inc ...IncludeOption) (*ArtistSearchResponse, error) {
+	return c.BrowseArtistsContext(context.Background(), entity, id, limit, offset, inc...)
+}
+
+// BrowseArtistsContext is like BrowseArtists but aborts the request once ctx is done.
+func (c *WS2Client) BrowseArtistsContext(ctx context.Context, entity string, id MBID, limit, offset int, inc ...IncludeOption) (*ArtistSearchResponse, error) {
+
+	result := artistListResult{}
+	err := c.browseRequest(ctx, "/artist", &result, entity, id, nil, limit, offset, inc)
+
+	rsp := ArtistSearchResponse{}
+	rsp.WS2ListResponse = result.ArtistList.WS2ListResponse
+
+	for _, v := range result.ArtistList.Artists {
+		rsp.Artists = append(rsp.Artists, v.Artist)
+	}
+
+	return &rsp, err
+}
+
+// ArtistSearchResponse is the response type returned by the SearchArtist and
+// BrowseArtists methods.
 type ArtistSearchResponse struct {
 	WS2ListResponse
-	Artists []*Artist
-	Scores  ScoreMap
+	Artists []*Artist `json:"artists,omitempty"`
+	Scores  ScoreMap  `json:"-"`
+}
+
+// HasMore reports whether there are artists after the ones in r.
+func (r *ArtistSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Artists))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *ArtistSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Artists))
 }
 
 // ResultsWithScore returns a slice of Artists with a min score.
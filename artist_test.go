@@ -26,9 +26,9 @@
 package gomusicbrainz
 
 import (
+	"net/url"
 	"reflect"
 	"testing"
-	"time"
 )
 
 func TestSearchArtist(t *testing.T) {
@@ -47,6 +47,7 @@ func TestSearchArtist(t *testing.T) {
 				SortName:       "0Gopher And Friends",
 				CountryCode:    "DE",
 				Gender:         "nogender",
+				IPIs:           []string{"00123456789"},
 				Area: Area{
 					ID:       "some-area-id",
 					Name:     "Augsburg",
@@ -57,13 +58,15 @@ func TestSearchArtist(t *testing.T) {
 					Name:     "Mountain View",
 					SortName: "Mountain View",
 				},
+				EndArea: Area{
+					ID:       "some-end-area-id",
+					Name:     "Sunnyvale",
+					SortName: "Sunnyvale",
+				},
 				Lifespan: Lifespan{
 					Ended: false,
-					Begin: BrainzTime{
-						Time:     time.Date(2007, 9, 21, 0, 0, 0, 0, time.UTC),
-						Accuracy: Day,
-					},
-					End: BrainzTime{Time: time.Time{}},
+					Begin: mustPartialDate("2007-09-21"),
+					End:   PartialDate{},
 				},
 				Aliases: []*Alias{
 					{
@@ -85,6 +88,13 @@ func TestSearchArtist(t *testing.T) {
 						Name:  "Golang",
 					},
 				},
+				Genres: []Genre{
+					{
+						ID:    "some-genre-id",
+						Count: 3,
+						Name:  "electronic",
+					},
+				},
 			},
 		},
 	}
@@ -93,7 +103,7 @@ func TestSearchArtist(t *testing.T) {
 	defer server.Close()
 	serveTestFile("/artist", "SearchArtist.xml", t)
 
-	returned, err := client.SearchArtist("Gopher", -1, -1)
+	returned, err := client.SearchArtist("Gopher")
 	if err != nil {
 		t.Error(err)
 	}
@@ -111,11 +121,12 @@ func TestLookupArtist(t *testing.T) {
 
 	want := Artist{
 		ID:             "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
-		Type:           "Group",
+		Type:           ArtistTypeGroup,
 		Name:           "Massive Attack",
 		Disambiguation: "",
 		SortName:       "Massive Attack",
 		CountryCode:    "",
+		ISNIs:          []string{"0000000123699799"},
 		Area: Area{
 			ID:       "40d758a4-b7c2-40f3-b439-5efbd2a3b038",
 			Name:     "Bristol",
@@ -134,31 +145,22 @@ func TestLookupArtist(t *testing.T) {
 		},
 		Lifespan: Lifespan{
 			Ended: false,
-			Begin: BrainzTime{
-				Time:     time.Date(1987, 1, 1, 0, 0, 0, 0, time.UTC),
-				Accuracy: Year,
-			},
-			End: BrainzTime{Time: time.Time{}},
+			Begin: mustPartialDate("1987"),
+			End:   PartialDate{},
 		},
 		Relations: TargetRelationsMap{
 			"artist": []Relation{
 				&ArtistRelation{
 					RelationAbstract: RelationAbstract{
-						TypeID:    "5be4c609-9afa-4ea0-910b-12ffb71e3821",
-						Type:      "member of band",
-						Target:    "54912e02-166c-49fe-ba95-cd77ef182390",
-						Direction: "backward",
-						Begin: BrainzTime{
-							Time:     time.Date(1987, 1, 1, 0, 0, 0, 0, time.UTC),
-							Accuracy: Year,
-						},
-						End: BrainzTime{
-							Time:     time.Date(1998, 1, 1, 0, 0, 0, 0, time.UTC),
-							Accuracy: Year,
-						},
-						Ended: true,
+						TypeID:     "5be4c609-9afa-4ea0-910b-12ffb71e3821",
+						Type:       "member of band",
+						Target:     "54912e02-166c-49fe-ba95-cd77ef182390",
+						Direction:  "backward",
+						Begin:      mustPartialDate("1987"),
+						End:        mustPartialDate("1998"),
+						Ended:      true,
+						Attributes: []string{"keyboard", "sampler"},
 					},
-					// TODO Attribute list
 					Artist: Artist{
 						ID:             "54912e02-166c-49fe-ba95-cd77ef182390",
 						Name:           "Mushroom",
@@ -175,15 +177,23 @@ func TestLookupArtist(t *testing.T) {
 						Target: "07832b54-8266-47d5-bb0e-62c7f2cf5da5",
 					},
 					Release: Release{
-						ID:      "07832b54-8266-47d5-bb0e-62c7f2cf5da5",
-						Title:   "Protection",
-						Quality: "normal",
-						Date: BrainzTime{
-							Time:     time.Date(1995, 1, 24, 0, 0, 0, 0, time.UTC),
-							Accuracy: Day,
-						},
+						ID:          "07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+						Title:       "Protection",
+						Quality:     "normal",
+						Date:        mustPartialDate("1995-01-24"),
 						CountryCode: "US",
-						Barcode:     "724383988327",
+						ReleaseEvents: []ReleaseEvent{
+							{
+								Date: mustPartialDate("1995-01-24"),
+								Area: &Area{
+									ID:            "489ce91b-6658-3307-9877-795b68554c98",
+									Name:          "United States",
+									SortName:      "United States",
+									ISO31661Codes: []ISO31661Code{"US"},
+								},
+							},
+						},
+						Barcode: "724383988327",
 					},
 				},
 			},
@@ -210,3 +220,64 @@ func TestLookupArtist(t *testing.T) {
 	}
 
 }
+
+func TestLookupArtistNotFound(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	_, err := client.LookupArtist("00000000-0000-0000-0000-000000000000")
+
+	if _, ok := err.(*NotFoundError); !ok {
+		t.Errorf("expected *NotFoundError, got %#v", err)
+	}
+}
+
+func TestLookupArtistMalformedID(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	if _, err := client.LookupArtist("not-an-mbid"); err == nil {
+		t.Error("expected error for malformed MBID")
+	}
+}
+
+func TestBrowseArtists(t *testing.T) {
+
+	want := ArtistSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		Artists: []*Artist{
+			{
+				ID:       "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
+				Type:     "Group",
+				Name:     "Massive Attack",
+				SortName: "Massive Attack",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/artist", "BrowseArtists.xml", url.Values{
+		"release": {"07832b54-8266-47d5-bb0e-62c7f2cf5da5"},
+		"limit":   {"10"},
+		"offset":  {"0"},
+	}, t)
+
+	returned, err := client.BrowseArtists(
+		"release",
+		"07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+		10, 0)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
@@ -0,0 +1,306 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"sync"
+)
+
+// batchConcurrency is the maximum number of lookups of a batch that are in
+// flight at the same time. The actual request rate is still bound by the rate
+// limit of the client.
+const batchConcurrency = 4
+
+// lookupBatch calls lookup for the indices 0 to n-1 with at most
+// batchConcurrency calls running concurrently and returns their errors in
+// order. Lookups that did not start before ctx is done fail with ctx.Err().
+func lookupBatch(ctx context.Context, n int, lookup func(ctx context.Context, i int) error) []error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, batchConcurrency)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			errs[i] = lookup(ctx, i)
+		}(i)
+	}
+	wg.Wait()
+
+	return errs
+}
+
+// LookupAreaBatch looks up the areas with the given MBIDs concurrently. The
+// results and errors are in the order of ids, the result of a failed lookup is
+// nil.
+func (c *WS2Client) LookupAreaBatch(ids []MBID, inc ...IncludeOption) ([]*Area, []error) {
+	return c.LookupAreaBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupAreaBatchContext is like LookupAreaBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupAreaBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*Area, []error) {
+	results := make([]*Area, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupAreaContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupArtistBatch looks up the artists with the given MBIDs concurrently. The
+// results and errors are in the order of ids, the result of a failed lookup is
+// nil.
+func (c *WS2Client) LookupArtistBatch(ids []MBID, inc ...IncludeOption) ([]*Artist, []error) {
+	return c.LookupArtistBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupArtistBatchContext is like LookupArtistBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupArtistBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*Artist, []error) {
+	results := make([]*Artist, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupArtistContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupEventBatch looks up the events with the given MBIDs concurrently. The
+// results and errors are in the order of ids, the result of a failed lookup is
+// nil.
+func (c *WS2Client) LookupEventBatch(ids []MBID, inc ...IncludeOption) ([]*Event, []error) {
+	return c.LookupEventBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupEventBatchContext is like LookupEventBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupEventBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*Event, []error) {
+	results := make([]*Event, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupEventContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupInstrumentBatch looks up the instruments with the given MBIDs
+// concurrently. The results and errors are in the order of ids, the result of a
+// failed lookup is nil.
+func (c *WS2Client) LookupInstrumentBatch(ids []MBID, inc ...IncludeOption) ([]*Instrument, []error) {
+	return c.LookupInstrumentBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupInstrumentBatchContext is like LookupInstrumentBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupInstrumentBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*Instrument, []error) {
+	results := make([]*Instrument, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupInstrumentContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupLabelBatch looks up the labels with the given MBIDs concurrently. The
+// results and errors are in the order of ids, the result of a failed lookup is
+// nil.
+func (c *WS2Client) LookupLabelBatch(ids []MBID, inc ...IncludeOption) ([]*Label, []error) {
+	return c.LookupLabelBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupLabelBatchContext is like LookupLabelBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupLabelBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*Label, []error) {
+	results := make([]*Label, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupLabelContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupPlaceBatch looks up the places with the given MBIDs concurrently. The
+// results and errors are in the order of ids, the result of a failed lookup is
+// nil.
+func (c *WS2Client) LookupPlaceBatch(ids []MBID, inc ...IncludeOption) ([]*Place, []error) {
+	return c.LookupPlaceBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupPlaceBatchContext is like LookupPlaceBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupPlaceBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*Place, []error) {
+	results := make([]*Place, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupPlaceContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupRecordingBatch looks up the recordings with the given MBIDs
+// concurrently. The results and errors are in the order of ids, the result of a
+// failed lookup is nil.
+func (c *WS2Client) LookupRecordingBatch(ids []MBID, inc ...IncludeOption) ([]*Recording, []error) {
+	return c.LookupRecordingBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupRecordingBatchContext is like LookupRecordingBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupRecordingBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*Recording, []error) {
+	results := make([]*Recording, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupRecordingContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupReleaseBatch looks up the releases with the given MBIDs concurrently.
+// The results and errors are in the order of ids, the result of a failed lookup
+// is nil.
+func (c *WS2Client) LookupReleaseBatch(ids []MBID, inc ...IncludeOption) ([]*Release, []error) {
+	return c.LookupReleaseBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupReleaseBatchContext is like LookupReleaseBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupReleaseBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*Release, []error) {
+	results := make([]*Release, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupReleaseContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupReleaseGroupBatch looks up the release groups with the given MBIDs
+// concurrently. The results and errors are in the order of ids, the result of a
+// failed lookup is nil.
+func (c *WS2Client) LookupReleaseGroupBatch(ids []MBID, inc ...IncludeOption) ([]*ReleaseGroup, []error) {
+	return c.LookupReleaseGroupBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupReleaseGroupBatchContext is like LookupReleaseGroupBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupReleaseGroupBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*ReleaseGroup, []error) {
+	results := make([]*ReleaseGroup, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupReleaseGroupContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupSeriesBatch looks up the series with the given MBIDs concurrently. The
+// results and errors are in the order of ids, the result of a failed lookup is
+// nil.
+func (c *WS2Client) LookupSeriesBatch(ids []MBID, inc ...IncludeOption) ([]*Series, []error) {
+	return c.LookupSeriesBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupSeriesBatchContext is like LookupSeriesBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupSeriesBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*Series, []error) {
+	results := make([]*Series, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupSeriesContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupURLBatch looks up the URLs with the given MBIDs concurrently. The
+// results and errors are in the order of ids, the result of a failed lookup is
+// nil.
+func (c *WS2Client) LookupURLBatch(ids []MBID, inc ...IncludeOption) ([]*URL, []error) {
+	return c.LookupURLBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupURLBatchContext is like LookupURLBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupURLBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*URL, []error) {
+	results := make([]*URL, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupURLContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
+
+// LookupWorkBatch looks up the works with the given MBIDs concurrently. The
+// results and errors are in the order of ids, the result of a failed lookup is
+// nil.
+func (c *WS2Client) LookupWorkBatch(ids []MBID, inc ...IncludeOption) ([]*Work, []error) {
+	return c.LookupWorkBatchContext(context.Background(), ids, inc...)
+}
+
+// LookupWorkBatchContext is like LookupWorkBatch but aborts the requests once ctx is done.
+func (c *WS2Client) LookupWorkBatchContext(ctx context.Context, ids []MBID, inc ...IncludeOption) ([]*Work, []error) {
+	results := make([]*Work, len(ids))
+	errs := lookupBatch(ctx, len(ids), func(ctx context.Context, i int) error {
+		r, err := c.LookupWorkContext(ctx, ids[i], inc...)
+		if err == nil {
+			results[i] = r
+		}
+		return err
+	})
+	return results, errs
+}
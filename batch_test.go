@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLookupArtistBatch(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/artist/10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8" {
+			http.NotFound(w, r)
+			return
+		}
+		http.ServeFile(w, r, "./testdata/LookupArtist.xml")
+	})
+
+	ids := []MBID{
+		"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
+		"00000000-0000-0000-0000-000000000000",
+		"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
+	}
+
+	artists, errs := client.LookupArtistBatch(ids)
+	if len(artists) != len(ids) || len(errs) != len(ids) {
+		t.Fatalf("expected %d results, got %d artists and %d errors", len(ids), len(artists), len(errs))
+	}
+
+	for _, i := range []int{0, 2} {
+		if errs[i] != nil {
+			t.Errorf("lookup %d: unexpected error %v", i, errs[i])
+		} else if artists[i] == nil || artists[i].ID != ids[i] {
+			t.Errorf("lookup %d: unexpected artist %v", i, artists[i])
+		}
+	}
+
+	if _, ok := errs[1].(*NotFoundError); !ok || artists[1] != nil {
+		t.Errorf("lookup 1: expected *NotFoundError and no artist, got %v, %v", errs[1], artists[1])
+	}
+}
+
+func TestLookupBatchConcurrency(t *testing.T) {
+	var mu sync.Mutex
+	running, maxRunning := 0, 0
+
+	errs := lookupBatch(context.Background(), 20, func(ctx context.Context, i int) error {
+		mu.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mu.Unlock()
+
+		time.Sleep(time.Millisecond)
+
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return nil
+	})
+
+	if len(errs) != 20 {
+		t.Errorf("expected 20 errors, got %d", len(errs))
+	}
+	if maxRunning > batchConcurrency {
+		t.Errorf("expected at most %d concurrent lookups, got %d", batchConcurrency, maxRunning)
+	}
+}
+
+func TestLookupBatchCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	errs := lookupBatch(ctx, 3, func(ctx context.Context, i int) error {
+		return ctx.Err()
+	})
+
+	for i, err := range errs {
+		if err != context.Canceled {
+			t.Errorf("lookup %d: expected context.Canceled, got %v", i, err)
+		}
+	}
+}
@@ -0,0 +1,221 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"bytes"
+	"container/list"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Cache stores WS2 responses keyed by request URL. Values are opaque byte
+// slices that contain the response body and its metadata. Implement it to
+// plug in a cache backend like Redis or Memcached, see WithCacheBackend.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the value stored for key and whether it was found.
+	Get(key string) ([]byte, bool)
+	// Set stores value for key. A ttl <= 0 means the value does not expire.
+	Set(key string, value []byte, ttl time.Duration)
+	// Delete removes the value stored for key.
+	Delete(key string)
+}
+
+// LRUCache is a size limited in-memory Cache that evicts the least recently
+// used entry first.
+type LRUCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ll         *list.List
+	entries    map[string]*list.Element
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time // zero means the entry does not expire
+}
+
+// NewLRUCache returns a cache holding up to maxEntries entries. If maxEntries
+// is <= 0 the number of entries is not limited.
+func NewLRUCache(maxEntries int) *LRUCache {
+	return &LRUCache{
+		maxEntries: maxEntries,
+		ll:         list.New(),
+		entries:    make(map[string]*list.Element),
+	}
+}
+
+// Get returns the value stored for key if it exists and has not expired.
+func (l *LRUCache) Get(key string) ([]byte, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	el, ok := l.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	e := el.Value.(*lruEntry)
+	if !e.expires.IsZero() && time.Now().After(e.expires) {
+		l.remove(el)
+		return nil, false
+	}
+
+	l.ll.MoveToFront(el)
+	return e.value, true
+}
+
+// Set stores value for key. A ttl <= 0 means the entry does not expire.
+func (l *LRUCache) Set(key string, value []byte, ttl time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var expires time.Time
+	if ttl > 0 {
+		expires = time.Now().Add(ttl)
+	}
+
+	if el, ok := l.entries[key]; ok {
+		e := el.Value.(*lruEntry)
+		e.value, e.expires = value, expires
+		l.ll.MoveToFront(el)
+		return
+	}
+
+	l.entries[key] = l.ll.PushFront(&lruEntry{key: key, value: value, expires: expires})
+
+	if l.maxEntries > 0 && l.ll.Len() > l.maxEntries {
+		l.remove(l.ll.Back())
+	}
+}
+
+// Delete removes the entry for key if present.
+func (l *LRUCache) Delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if el, ok := l.entries[key]; ok {
+		l.remove(el)
+	}
+}
+
+// Clear removes all entries.
+func (l *LRUCache) Clear() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.ll.Init()
+	l.entries = make(map[string]*list.Element)
+}
+
+func (l *LRUCache) remove(el *list.Element) {
+	l.ll.Remove(el)
+	delete(l.entries, el.Value.(*lruEntry).key)
+}
+
+// cacheEntry is a cached response together with its HTTP validators which
+// allow revalidating the response with a conditional request once it is
+// stale.
+type cacheEntry struct {
+	expires      time.Time // zero means the entry never becomes stale
+	etag         string
+	lastModified string
+	body         []byte
+}
+
+func newCacheEntry(body []byte, header http.Header, ttl time.Duration) *cacheEntry {
+	e := &cacheEntry{
+		etag:         header.Get("ETag"),
+		lastModified: header.Get("Last-Modified"),
+		body:         body,
+	}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+	return e
+}
+
+// fresh reports whether e can be used without revalidation.
+func (e *cacheEntry) fresh() bool {
+	return e.expires.IsZero() || time.Now().Before(e.expires)
+}
+
+// revalidatable reports whether e can be revalidated with a conditional
+// request.
+func (e *cacheEntry) revalidatable() bool {
+	return e.etag != "" || e.lastModified != ""
+}
+
+// marshal encodes e as a tab separated header line followed by the body.
+// Header values can't contain tabs or newlines.
+func (e *cacheEntry) marshal() []byte {
+	var expires int64
+	if !e.expires.IsZero() {
+		expires = e.expires.UnixNano()
+	}
+
+	var b bytes.Buffer
+	b.WriteString(strconv.FormatInt(expires, 10))
+	b.WriteByte('\t')
+	b.WriteString(e.etag)
+	b.WriteByte('\t')
+	b.WriteString(e.lastModified)
+	b.WriteByte('\n')
+	b.Write(e.body)
+	return b.Bytes()
+}
+
+// unmarshalCacheEntry decodes an entry encoded by marshal.
+func unmarshalCacheEntry(data []byte) (*cacheEntry, bool) {
+	i := bytes.IndexByte(data, '\n')
+	if i < 0 {
+		return nil, false
+	}
+
+	fields := strings.Split(string(data[:i]), "\t")
+	if len(fields) != 3 {
+		return nil, false
+	}
+	expires, err := strconv.ParseInt(fields[0], 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	e := &cacheEntry{
+		etag:         fields[1],
+		lastModified: fields[2],
+		body:         data[i+1:],
+	}
+	if expires != 0 {
+		e.expires = time.Unix(0, expires)
+	}
+	return e, true
+}
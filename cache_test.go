@@ -0,0 +1,198 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"io/ioutil"
+	"net/http"
+	"path"
+	"testing"
+	"time"
+)
+
+func TestLRUCacheEviction(t *testing.T) {
+
+	c := NewLRUCache(2)
+	c.Set("a", []byte("1"), 0)
+	c.Set("b", []byte("2"), 0)
+	c.Get("a") // b is now the least recently used entry
+	c.Set("c", []byte("3"), 0)
+
+	if _, ok := c.Get("b"); ok {
+		t.Error("expected b to be evicted")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("expected %s to be cached", key)
+		}
+	}
+
+	c.Delete("a")
+	if _, ok := c.Get("a"); ok {
+		t.Error("expected a to be deleted")
+	}
+}
+
+func TestLRUCacheExpiry(t *testing.T) {
+
+	c := NewLRUCache(0)
+	c.Set("a", []byte("1"), time.Millisecond)
+	c.Set("b", []byte("2"), 0)
+
+	time.Sleep(5 * time.Millisecond)
+
+	if _, ok := c.Get("a"); ok {
+		t.Error("expected a to be expired")
+	}
+	if v, ok := c.Get("b"); !ok || string(v) != "2" {
+		t.Errorf("expected b to be cached, got %q", v)
+	}
+}
+
+func TestClientCache(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	requests := 0
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.ServeFile(w, r, path.Join("./testdata", "SearchArtist.xml"))
+	})
+
+	WithCache(10, time.Minute)(client)
+
+	for i := 0; i < 3; i++ {
+		rsp, err := client.SearchArtist("Gopher")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rsp.Artists) == 0 {
+			t.Fatal("expected artists from cached response")
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+
+	client.ClearCache()
+
+	if _, err := client.SearchArtist("Gopher"); err != nil {
+		t.Fatal(err)
+	}
+	if requests != 2 {
+		t.Errorf("expected a new request after ClearCache, got %d requests", requests)
+	}
+}
+
+// mapCache is a minimal Cache backend without Clear method.
+type mapCache map[string][]byte
+
+func (m mapCache) Get(key string) ([]byte, bool) {
+	v, ok := m[key]
+	return v, ok
+}
+
+func (m mapCache) Set(key string, value []byte, ttl time.Duration) {
+	m[key] = value
+}
+
+func (m mapCache) Delete(key string) {
+	delete(m, key)
+}
+
+func TestCacheBackend(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	requests := 0
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		http.ServeFile(w, r, path.Join("./testdata", "SearchArtist.xml"))
+	})
+
+	backend := mapCache{}
+	WithCacheBackend(backend)(client)
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.SearchArtist("Gopher"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+	if len(backend) != 1 {
+		t.Errorf("expected 1 cached response, got %d", len(backend))
+	}
+
+	// must not panic for backends without Clear
+	client.ClearCache()
+}
+
+func TestCacheRevalidation(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	requests, notModified := 0, 0
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("If-None-Match") == `"v1"` &&
+			r.Header.Get("If-Modified-Since") == "Mon, 02 Jan 2006 15:04:05 GMT" {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Mon, 02 Jan 2006 15:04:05 GMT")
+		// don't use http.ServeFile, it overrides Last-Modified
+		body, err := ioutil.ReadFile(path.Join("./testdata", "SearchArtist.xml"))
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		w.Write(body)
+	})
+
+	// responses become stale right away
+	WithCache(10, time.Nanosecond)(client)
+
+	for i := 0; i < 3; i++ {
+		rsp, err := client.SearchArtist("Gopher")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rsp.Artists) == 0 {
+			t.Fatal("expected artists from revalidated response")
+		}
+	}
+
+	if requests != 3 || notModified != 2 {
+		t.Errorf("expected 3 requests of which 2 were conditional, got %d and %d", requests, notModified)
+	}
+}
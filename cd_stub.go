@@ -25,16 +25,18 @@
 
 package gomusicbrainz
 
+import "context"
+
 // CDStub represents an anonymously submitted track list.
 type CDStub struct {
-	ID        string `xml:"id,attr"` // seems not to be a valid MBID (UUID)
-	Title     string `xml:"title"`
-	Artist    string `xml:"artist"`
-	Barcode   string `xml:"barcode"`
-	Comment   string `xml:"comment"`
+	ID        string `xml:"id,attr" json:"id,omitempty"` // seems not to be a valid MBID (UUID)
+	Title     string `xml:"title" json:"title,omitempty"`
+	Artist    string `xml:"artist" json:"artist,omitempty"`
+	Barcode   string `xml:"barcode" json:"barcode,omitempty"`
+	Comment   string `xml:"comment" json:"comment,omitempty"`
 	TrackList struct {
 		Count int `xml:"count,attr"`
-	} `xml:"track-list"`
+	} `xml:"track-list" json:"trackList"`
 }
 
 // SearchCDStub queries MusicBrainz´ Search Server for CDStubs.
@@ -51,10 +53,15 @@ type CDStub struct {
 // With no fields specified searchTerm searches only the artist Field. For more
 // information visit
 // https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#CDStubs
-func (c *WS2Client) SearchCDStub(searchTerm string, limit, offset int) (*CDStubSearchResponse, error) {
+func (c *WS2Client) SearchCDStub(searchTerm string, opts ...SearchOption) (*CDStubSearchResponse, error) {
+	return c.SearchCDStubContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchCDStubContext is like SearchCDStub but aborts the request once ctx is done.
+func (c *WS2Client) SearchCDStubContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*CDStubSearchResponse, error) {
 
 	result := cdStubListResult{}
-	err := c.searchRequest("/cdstub", &result, searchTerm, limit, offset)
+	err := c.searchRequest(ctx, "/cdstub", &result, searchTerm, opts)
 
 	rsp := CDStubSearchResponse{}
 	rsp.WS2ListResponse = result.CDStubList.WS2ListResponse
@@ -71,8 +78,18 @@ func (c *WS2Client) SearchCDStub(searchTerm string, limit, offset int) (*CDStubS
 // CDStubSearchResponse is the response type returned by the SearchCDStub method.
 type CDStubSearchResponse struct {
 	WS2ListResponse
-	CDStubs []*CDStub
-	Scores  ScoreMap
+	CDStubs []*CDStub `json:"cdStubs,omitempty"`
+	Scores  ScoreMap  `json:"-"`
+}
+
+// HasMore reports whether there are CD stubs after the ones in r.
+func (r *CDStubSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.CDStubs))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *CDStubSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.CDStubs))
 }
 
 // ResultsWithScore returns a slice of CDStubs with a min score.
@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Config holds the settings of a WS2Client for NewWS2ClientFromConfig e.g. when
+// they are read from a configuration file. Zero values select the defaults of
+// NewWS2Client. To disable retries or rate limiting pass WithMaxRetries(0) or
+// WithRateLimit(0) to NewWS2ClientFromConfig.
+type Config struct {
+	RootURL           string
+	AppName           string
+	Version           string
+	Contact           string
+	Timeout           time.Duration
+	MaxRetries        int
+	RequestsPerSecond float64
+}
+
+// Validate checks cfg for missing or invalid settings and returns an error
+// describing the first one found.
+func (cfg Config) Validate() error {
+	if cfg.AppName == "" {
+		return errors.New("invalid config: AppName must not be empty.")
+	}
+
+	if cfg.RootURL != "" {
+		u, err := url.Parse(cfg.RootURL)
+		if err != nil {
+			return fmt.Errorf("invalid config: malformed RootURL %q: %v.", cfg.RootURL, err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("invalid config: RootURL %q must be an absolute http(s) URL.", cfg.RootURL)
+		}
+	}
+
+	if cfg.Timeout < 0 {
+		return fmt.Errorf("invalid config: negative Timeout %v.", cfg.Timeout)
+	}
+
+	if cfg.MaxRetries < 0 {
+		return fmt.Errorf("invalid config: negative MaxRetries %d.", cfg.MaxRetries)
+	}
+
+	if cfg.RequestsPerSecond < 0 {
+		return fmt.Errorf("invalid config: negative RequestsPerSecond %v.", cfg.RequestsPerSecond)
+	}
+
+	return nil
+}
+
+// NewWS2ClientFromConfig validates cfg and returns a new WS2Client configured
+// accordingly. Further options can be given to e.g. enable caching.
+func NewWS2ClientFromConfig(cfg Config, opts ...Option) (*WS2Client, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+
+	cfgOpts := []Option{WithAppInfo(cfg.AppName, cfg.Version, cfg.Contact)}
+
+	if cfg.RootURL != "" {
+		cfgOpts = append(cfgOpts, WithRootURL(cfg.RootURL))
+	}
+	if cfg.Timeout > 0 {
+		cfgOpts = append(cfgOpts, WithTimeout(cfg.Timeout))
+	}
+	if cfg.MaxRetries > 0 {
+		cfgOpts = append(cfgOpts, WithMaxRetries(cfg.MaxRetries))
+	}
+	if cfg.RequestsPerSecond > 0 {
+		cfgOpts = append(cfgOpts, WithRateLimit(cfg.RequestsPerSecond))
+	}
+
+	return NewWS2Client(append(cfgOpts, opts...)...)
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConfigValidate(t *testing.T) {
+
+	tests := []struct {
+		cfg   Config
+		valid bool
+	}{
+		{Config{AppName: "App"}, true},
+		{Config{AppName: "App", RootURL: "https://mb.example.org", Timeout: time.Second}, true},
+		{Config{}, false},
+		{Config{AppName: "App", RootURL: "mb.example.org"}, false},
+		{Config{AppName: "App", RootURL: "ftp://mb.example.org"}, false},
+		{Config{AppName: "App", RootURL: "http://%zz"}, false},
+		{Config{AppName: "App", Timeout: -time.Second}, false},
+		{Config{AppName: "App", MaxRetries: -1}, false},
+		{Config{AppName: "App", RequestsPerSecond: -1}, false},
+	}
+
+	for _, test := range tests {
+		err := test.cfg.Validate()
+		if test.valid && err != nil {
+			t.Errorf("%+v: unexpected error %v", test.cfg, err)
+		}
+		if !test.valid && err == nil {
+			t.Errorf("%+v: expected error", test.cfg)
+		}
+	}
+}
+
+func TestNewWS2ClientFromConfig(t *testing.T) {
+
+	c, err := NewWS2ClientFromConfig(Config{
+		RootURL:           "https://mb.example.org",
+		AppName:           "App",
+		Version:           "1.0",
+		Contact:           "app@example.org",
+		Timeout:           5 * time.Second,
+		MaxRetries:        3,
+		RequestsPerSecond: 2,
+	}, WithMaxRetries(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := c.WS2RootURL.String(); got != "https://mb.example.org/ws/2" {
+		t.Errorf("unexpected root URL %q", got)
+	}
+	if c.Timeout != 5*time.Second {
+		t.Errorf("unexpected timeout %v", c.Timeout)
+	}
+	// options given to NewWS2ClientFromConfig override cfg
+	if c.MaxRetries != 0 {
+		t.Errorf("unexpected max retries %d", c.MaxRetries)
+	}
+	if c.limiter.interval != 500*time.Millisecond {
+		t.Errorf("unexpected rate limit interval %v", c.limiter.interval)
+	}
+
+	d, err := NewWS2ClientFromConfig(Config{AppName: "App"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.WS2RootURL.String() != DefaultRootURL || d.Timeout != DefaultTimeout || d.MaxRetries != DefaultMaxRetries {
+		t.Errorf("expected defaults, got %+v", d)
+	}
+
+	if _, err := NewWS2ClientFromConfig(Config{}); err == nil {
+		t.Error("expected error for invalid config")
+	}
+}
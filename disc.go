@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"net/url"
+	"strings"
+)
+
+// Disc represents a CD identified by its disc ID, a hash calculated from the
+// table of contents (TOC) of the disc. See https://musicbrainz.org/doc/Disc_ID
+type Disc struct {
+	ID      string `xml:"id,attr" json:"id,omitempty"`
+	Sectors int    `xml:"sectors" json:"sectors,omitempty"`
+	// Offsets are the start sectors of the tracks in track order.
+	Offsets []int `xml:"offset-list>offset" json:"offsets,omitempty"`
+}
+
+// DiscIDLookupResponse is the response type returned by the LookupByDiscID
+// and LookupByTOC methods.
+type DiscIDLookupResponse struct {
+	// Disc is nil if no disc matched exactly, e.g. for a fuzzy TOC lookup.
+	Disc     *Disc      `json:"disc,omitempty"`
+	Releases []*Release `json:"releases,omitempty"`
+}
+
+// LookupByDiscID performs a lookup request for the releases containing the
+// disc with the given disc ID e.g. "I5l9cCSFccLKFEKS.7wqSZAorPU-".
+//
+// Possible inc params are artists, labels, recordings, release-groups,
+// artist-credits, aliases, isrcs and <ENTITY>-rels e.g. url-rels.
+func (c *WS2Client) LookupByDiscID(discID string, inc ...IncludeOption) (*DiscIDLookupResponse, error) {
+	return c.LookupByDiscIDContext(context.Background(), discID, inc...)
+}
+
+// LookupByDiscIDContext is like LookupByDiscID but aborts the request once ctx is done.
+func (c *WS2Client) LookupByDiscIDContext(ctx context.Context, discID string, inc ...IncludeOption) (*DiscIDLookupResponse, error) {
+	if discID == "" {
+		return nil, errors.New("can't perform lookup without disc ID.")
+	}
+	return c.discIDRequest(ctx, discID, "", inc)
+}
+
+// LookupByTOC performs a fuzzy lookup request for the releases containing a
+// disc with a table of contents similar to toc. toc consists of the first
+// track number, the last track number, the total number of sectors and the
+// offset of each track, separated by spaces or "+" e.g.
+//
+//	"1 2 29522 150 15555"
+//
+// Possible inc params are the same as for LookupByDiscID.
+func (c *WS2Client) LookupByTOC(toc string, inc ...IncludeOption) (*DiscIDLookupResponse, error) {
+	return c.LookupByTOCContext(context.Background(), toc, inc...)
+}
+
+// LookupByTOCContext is like LookupByTOC but aborts the request once ctx is done.
+func (c *WS2Client) LookupByTOCContext(ctx context.Context, toc string, inc ...IncludeOption) (*DiscIDLookupResponse, error) {
+	if toc == "" {
+		return nil, errors.New("can't perform lookup without TOC.")
+	}
+	// "-" matches no disc ID so only the TOC is used
+	return c.discIDRequest(ctx, "-", toc, inc)
+}
+
+// discIDRequest performs a disc ID lookup. If toc is not empty WS2 falls back
+// to a fuzzy TOC lookup in case no disc with discID exists.
+func (c *WS2Client) discIDRequest(ctx context.Context, discID, toc string, inc []IncludeOption) (*DiscIDLookupResponse, error) {
+
+	params := encodeInc(inc)
+	if params == nil {
+		params = url.Values{}
+	}
+	if toc != "" {
+		// WS2 expects the TOC fields to be separated by "+" which is
+		// what an encoded space looks like
+		params.Set("toc", strings.Replace(toc, "+", " ", -1))
+	}
+
+	// an exact match returns a disc element, a fuzzy one a release-list
+	var result struct {
+		XMLName xml.Name `xml:"metadata"`
+		Disc    *struct {
+			Disc
+			Releases []*Release `xml:"release-list>release"`
+		} `xml:"disc"`
+		Releases []*Release `xml:"release-list>release"`
+	}
+	err := c.getRequest(ctx, &result, params, "/discid/"+url.PathEscape(discID))
+
+	rsp := DiscIDLookupResponse{Releases: result.Releases}
+	if result.Disc != nil {
+		rsp.Disc = &result.Disc.Disc
+		rsp.Releases = result.Disc.Releases
+	}
+
+	return &rsp, err
+}
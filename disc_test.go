@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestLookupByDiscID(t *testing.T) {
+
+	want := DiscIDLookupResponse{
+		Disc: &Disc{
+			ID:      "I5l9cCSFccLKFEKS.7wqSZAorPU-",
+			Sectors: 29522,
+			Offsets: []int{150, 15555},
+		},
+		Releases: []*Release{
+			&Release{
+				ID:     "07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+				Title:  "Protection",
+				Status: ReleaseStatusOfficial,
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/discid/I5l9cCSFccLKFEKS.7wqSZAorPU-", "LookupByDiscID.xml", t)
+
+	returned, err := client.LookupByDiscID("I5l9cCSFccLKFEKS.7wqSZAorPU-")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupByTOC(t *testing.T) {
+
+	want := DiscIDLookupResponse{
+		Releases: []*Release{
+			&Release{
+				ID:     "07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+				Title:  "Protection",
+				Status: ReleaseStatusOfficial,
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/discid/-", "LookupByTOC.xml", url.Values{
+		"toc": {"1 2 29522 150 15555"},
+	}, t)
+
+	returned, err := client.LookupByTOC("1+2+29522+150+15555")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
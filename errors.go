@@ -0,0 +1,130 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"time"
+)
+
+// NotFoundError is returned if WS2 responds with HTTP status 404, e.g. when
+// looking up an MBID that does not exist.
+type NotFoundError struct {
+	URL string // the requested URL
+}
+
+func (e *NotFoundError) Error() string {
+	return "not found: " + e.URL
+}
+
+// RateLimitError is returned if WS2 still responds with HTTP status 503
+// Service Unavailable after all retries.
+type RateLimitError struct {
+	URL     string // the requested URL
+	Retries int    // the number of retries performed
+	// RetryAfter is the wait time requested by the Retry-After header of the
+	// last response or 0 if there was none.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("rate limit exceeded after %d retries: %s", e.Retries, e.URL)
+}
+
+// BadRequestError is returned if WS2 responds with HTTP status 400, e.g. for
+// an invalid search query or inc param.
+type BadRequestError struct {
+	URL     string // the requested URL
+	Message string // the error message sent by WS2, if any
+}
+
+func (e *BadRequestError) Error() string {
+	if e.Message == "" {
+		return "bad request: " + e.URL
+	}
+	return fmt.Sprintf("bad request: %s: %s", e.Message, e.URL)
+}
+
+// AuthRequiredError is returned if WS2 responds with HTTP status 401 which
+// means the requested data is only available to authenticated users.
+type AuthRequiredError struct {
+	URL     string // the requested URL
+	Message string // the error message sent by WS2, if any
+}
+
+func (e *AuthRequiredError) Error() string {
+	if e.Message == "" {
+		return "authentication required: " + e.URL
+	}
+	return fmt.Sprintf("authentication required: %s: %s", e.Message, e.URL)
+}
+
+// StatusError is returned if WS2 responds with a non-2xx HTTP status that is
+// not covered by a more specific error type.
+type StatusError struct {
+	URL        string // the requested URL
+	StatusCode int    // the HTTP status code of the response
+	Message    string // the error message sent by WS2, if any
+}
+
+func (e *StatusError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("unexpected status %d: %s", e.StatusCode, e.URL)
+	}
+	return fmt.Sprintf("unexpected status %d: %s: %s", e.StatusCode, e.Message, e.URL)
+}
+
+// XMLDecodeError is returned if the response of WS2 could not be decoded.
+type XMLDecodeError struct {
+	URL string // the requested URL
+	Err error  // the error returned by the XML decoder
+}
+
+func (e *XMLDecodeError) Error() string {
+	return fmt.Sprintf("decoding response of %s: %v", e.URL, e.Err)
+}
+
+// Unwrap returns the underlying decoder error.
+func (e *XMLDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// wsErrorMessage extracts the message from an error document sent by WS2 e.g.
+//
+//	<error><text>Invalid mbid.</text></error>
+//
+// An empty string is returned if r does not contain such a document.
+func wsErrorMessage(r io.Reader) string {
+	var doc struct {
+		Texts []string `xml:"text"`
+	}
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil || len(doc.Texts) == 0 {
+		return ""
+	}
+	return doc.Texts[0]
+}
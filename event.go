@@ -0,0 +1,201 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"encoding/xml"
+)
+
+// Event represents an organised event which people can attend e.g. a concert,
+// a festival or an award ceremony. See https://musicbrainz.org/doc/Event
+type Event struct {
+	ID             MBID               `xml:"id,attr" json:"id,omitempty"`
+	Type           string             `xml:"type,attr" json:"type,omitempty"`
+	Name           string             `xml:"name" json:"name,omitempty"`
+	Disambiguation string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	Cancelled      bool               `xml:"cancelled" json:"cancelled,omitempty"`
+	Lifespan       Lifespan           `xml:"life-span" json:"lifespan,omitempty"`
+	Time           string             `xml:"time" json:"time,omitempty"`
+	Setlist        string             `xml:"setlist" json:"setlist,omitempty"`
+	Aliases        []*Alias           `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Tags           []Tag              `xml:"tag-list>tag" json:"tags,omitempty"`
+	Genres         []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Rating         Rating             `xml:"rating" json:"rating,omitempty"`
+	UserRating     UserRating         `xml:"user-rating" json:"userRating,omitempty"`
+	Annotation     string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations      TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
+}
+
+func (mbe *Event) lookupResult() interface{} {
+	var res struct {
+		XMLName xml.Name `xml:"metadata"`
+		Ptr     *Event   `xml:"event"`
+	}
+	res.Ptr = mbe
+	return &res
+}
+
+func (mbe *Event) apiEndpoint() string {
+	return "/event"
+}
+
+func (mbe *Event) Id() MBID {
+	return mbe.ID
+}
+
+// String returns the name, type, begin date and MBID of the event.
+func (mbe *Event) String() string {
+	return entityString(mbe.Name, mbe.ID, mbe.Type, mbe.Lifespan.Begin.String())
+}
+
+// LookupEvent performs an event lookup request for the given MBID.
+//
+// Possible inc params are aliases, tags, genres, ratings, annotation and
+// <ENTITY>-rels e.g. artist-rels, place-rels, area-rels or url-rels.
+func (c *WS2Client) LookupEvent(id MBID, inc ...IncludeOption) (*Event, error) {
+	return c.LookupEventContext(context.Background(), id, inc...)
+}
+
+// LookupEventContext is like LookupEvent but aborts the request once ctx is done.
+func (c *WS2Client) LookupEventContext(ctx context.Context, id MBID, inc ...IncludeOption) (*Event, error) {
+	a := &Event{ID: id}
+	err := c.LookupContext(ctx, a, inc...)
+
+	return a, err
+}
+
+// SearchEvent queries MusicBrainz´ Search Server for Events.
+//
+// Possible search fields to provide in searchTerm are:
+//
+//	aid          MBID of an area related to the event
+//	alias        the aliases/misspellings for the event
+//	area         name of an area related to the event
+//	arid         MBID of an artist related to the event
+//	artist       name of an artist related to the event
+//	begin        event begin date
+//	comment      disambiguation comment
+//	eid          MBID of the event
+//	end          event end date
+//	ended        true if the event has ended
+//	event        name of the event
+//	eventaccent  name of the event with any accent characters retained
+//	pid          MBID of a place related to the event
+//	place        name of a place related to the event
+//	tag          folksonomy tag
+//	type         event type (e.g. concert, festival)
+//
+// With no fields specified searchTerm searches the event and alias fields.
+// For more information visit
+// https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Event
+func (c *WS2Client) SearchEvent(searchTerm string, opts ...SearchOption) (*EventSearchResponse, error) {
+	return c.SearchEventContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchEventContext is like SearchEvent but aborts the request once ctx is done.
+func (c *WS2Client) SearchEventContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*EventSearchResponse, error) {
+
+	result := eventListResult{}
+	err := c.searchRequest(ctx, "/event", &result, searchTerm, opts)
+
+	rsp := EventSearchResponse{}
+	rsp.WS2ListResponse = result.EventList.WS2ListResponse
+	rsp.Scores = make(ScoreMap)
+
+	for i, v := range result.EventList.Events {
+		rsp.Events = append(rsp.Events, v.Event)
+		rsp.Scores[rsp.Events[i]] = v.Score
+	}
+
+	return &rsp, err
+}
+
+// BrowseEvents performs a browse request for Events linked to the entity
+// with the given MBID. Possible linked entities are area, artist and place e.g.
+//
+//	client.BrowseEvents("place", "4352063b-a833-421b-a420-e7fb295dece0", -1, -1)
+//
+// Possible inc params are the same as for LookupEvent. Scores of the
+// returned response are not populated since browse requests are not ranked.
+func (c *WS2Client) BrowseEvents(entity string, id MBID, limit, offset int, inc ...IncludeOption) (*EventSearchResponse, error) {
+	return c.BrowseEventsContext(context.Background(), entity, id, limit, offset, inc...)
+}
+
+// BrowseEventsContext is like BrowseEvents but aborts the request once ctx is done.
+func (c *WS2Client) BrowseEventsContext(ctx context.Context, entity string, id MBID, limit, offset int, inc ...IncludeOption) (*EventSearchResponse, error) {
+
+	result := eventListResult{}
+	err := c.browseRequest(ctx, "/event", &result, entity, id, nil, limit, offset, inc)
+
+	rsp := EventSearchResponse{}
+	rsp.WS2ListResponse = result.EventList.WS2ListResponse
+
+	for _, v := range result.EventList.Events {
+		rsp.Events = append(rsp.Events, v.Event)
+	}
+
+	return &rsp, err
+}
+
+// EventSearchResponse is the response type returned by the SearchEvent and
+// BrowseEvents methods.
+type EventSearchResponse struct {
+	WS2ListResponse
+	Events []*Event `json:"events,omitempty"`
+	Scores ScoreMap `json:"-"`
+}
+
+// HasMore reports whether there are events after the ones in r.
+func (r *EventSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Events))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *EventSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Events))
+}
+
+// ResultsWithScore returns a slice of Events with a min score.
+func (r *EventSearchResponse) ResultsWithScore(score int) []*Event {
+	var res []*Event
+	for _, v := range r.Events {
+		if r.Scores[v] >= score {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+type eventListResult struct {
+	EventList struct {
+		WS2ListResponse
+		Events []struct {
+			*Event
+			Score int `xml:"http://musicbrainz.org/ns/ext#-2.0 score,attr"`
+		} `xml:"event"`
+	} `xml:"event-list"`
+}
@@ -0,0 +1,174 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestSearchEvent(t *testing.T) {
+
+	want := EventSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		Events: []*Event{
+			{
+				ID:             "fe39727a-3d21-4066-9345-3970cbd6cca4",
+				Type:           "Concert",
+				Name:           "Gopher Fest 2014",
+				Disambiguation: "first edition",
+				Lifespan: Lifespan{
+					Begin: mustPartialDate("2014-06-21"),
+					End:   mustPartialDate("2014-06-22"),
+				},
+				Time:    "19:30",
+				Setlist: "* [some-artist-id|Gopher And Friends]",
+				Relations: TargetRelationsMap{
+					"artist": []Relation{
+						&ArtistRelation{
+							RelationAbstract: RelationAbstract{
+								TypeID:    "936c7c95-3156-3889-a062-8a0cd57f8946",
+								Type:      "main performer",
+								Target:    "some-artist-id",
+								Direction: "backward",
+							},
+							Artist: Artist{
+								ID:       "some-artist-id",
+								Name:     "Gopher And Friends",
+								SortName: "0Gopher And Friends",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/event", "SearchEvent.xml", t)
+
+	returned, err := client.SearchEvent("Gopher Fest")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want.Scores = ScoreMap{
+		returned.Events[0]: 100,
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupEvent(t *testing.T) {
+
+	want := Event{
+		ID:        "fe39727a-3d21-4066-9345-3970cbd6cca4",
+		Type:      "Concert",
+		Name:      "Gopher Fest 2014",
+		Cancelled: true,
+		Lifespan: Lifespan{
+			Begin: mustPartialDate("2014-06-21"),
+		},
+		Relations: TargetRelationsMap{
+			"place": []Relation{
+				&PlaceRelation{
+					RelationAbstract: RelationAbstract{
+						TypeID: "e2c6f697-07dc-38b1-be0b-83d740165532",
+						Type:   "held at",
+						Target: "4352063b-a833-421b-a420-e7fb295dece0",
+					},
+					Place: Place{
+						ID:   "4352063b-a833-421b-a420-e7fb295dece0",
+						Type: "Studio",
+						Name: "Abbey Road Studios",
+					},
+				},
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile(
+		"/event/fe39727a-3d21-4066-9345-3970cbd6cca4",
+		"LookupEvent.xml", t)
+
+	returned, err := client.LookupEvent(
+		"fe39727a-3d21-4066-9345-3970cbd6cca4",
+		"place-rels")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestBrowseEvents(t *testing.T) {
+
+	want := EventSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		Events: []*Event{
+			{
+				ID:   "fe39727a-3d21-4066-9345-3970cbd6cca4",
+				Type: "Concert",
+				Name: "Gopher Fest 2014",
+				Time: "19:30",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/event", "BrowseEvents.xml", url.Values{
+		"place": {"4352063b-a833-421b-a420-e7fb295dece0"},
+	}, t)
+
+	returned, err := client.BrowseEvents(
+		"place",
+		"4352063b-a833-421b-a420-e7fb295dece0",
+		-1, -1)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
@@ -25,15 +25,30 @@
 
 package gomusicbrainz
 
-type Freedb struct {
-	//TODO implement
-}
+import "errors"
 
-func (c *WS2Client) SearchFreedb(searchTerm string, limit, offset int) (*FreedbSearchResponse, error) {
-	//TODO implement
-	return nil, nil
-}
+// ErrFreedbUnsupported is returned by SearchFreedb.
+var ErrFreedbUnsupported = errors.New("freedb search is no longer provided by MusicBrainz")
 
-type FreedbSearchResponse struct {
-	//TODO implement
+// Freedb represents a FreeDB disc entry.
+//
+// Deprecated: FreeDB was shut down and MusicBrainz no longer provides the
+// /freedb search endpoint.
+type Freedb struct{}
+
+// SearchFreedb always returns ErrFreedbUnsupported. To identify a CD use its
+// MusicBrainz disc ID with the /discid lookup endpoint instead, see
+// https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2#discid
+//
+// Deprecated: FreeDB was shut down and MusicBrainz no longer provides the
+// /freedb search endpoint.
+func (c *WS2Client) SearchFreedb(searchTerm string, opts ...SearchOption) (*FreedbSearchResponse, error) {
+	return nil, ErrFreedbUnsupported
 }
+
+// FreedbSearchResponse is the response type returned by the SearchFreedb
+// method.
+//
+// Deprecated: FreeDB was shut down and MusicBrainz no longer provides the
+// /freedb search endpoint.
+type FreedbSearchResponse struct{}
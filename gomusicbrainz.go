@@ -34,14 +34,16 @@ Search requests
 With search requests you can search MusicBrainz´ database for all entities.
 GoMusicBrainz implements one search method for every search request in the form:
 
-	func (*WS2Client) Search<ENTITY>(searchTerm, limit, offset) (<ENTITY>SearchResponse, error)
+	func (*WS2Client) Search<ENTITY>(searchTerm string, opts ...SearchOption) (<ENTITY>SearchResponse, error)
 
 searchTerm follows the Apache Lucene syntax and can either contain multiple
 fields with logical operators or just a simple search string. Please refer to
 https://lucene.apache.org/core/4_3_0/queryparser/org/apache/lucene/queryparser/classic/package-summary.html#package_description
-for more details on the lucene syntax. limit defines how many entries should be
-returned (1-100, default 25). offset is used for paging through more than one
-page of results. To ignore limit and/or offset, set it to -1.
+for more details on the lucene syntax. The options WithLimit and WithOffset
+set how many entries should be returned (1-100, default 25) and the offset
+used for paging through more than one page of results, e.g.
+
+	client.SearchArtist("Gopher", gomusicbrainz.WithLimit(10), gomusicbrainz.WithOffset(20))
 
 
 Lookup requests
@@ -51,65 +53,228 @@ GoMusicBrainz provides two ways to perform lookup requests: Either the specific
 lookup method that is implemented for each entity that has a lookup endpoint
 in the form
 
-	func(*WS2Client) Lookup<ETITY>(id MBID, inc ...string) (*<ENTITY>, error)
+	func(*WS2Client) Lookup<ETITY>(id MBID, inc ...IncludeOption) (*<ENTITY>, error)
 
 or the common lookup method if you already have an entity (with MBID) that
 implements the MBLookupEntity interface:
 
-	func(*WS2Client) Lookup(entity MBLookupEntity, inc ...string) error
+	func(*WS2Client) Lookup(entity MBLookupEntity, inc ...IncludeOption) error
 
 With both methods you can include inc params which affect subqueries e.g.
 relationships. see
 http://musicbrainz.org/doc/Development/XML_Web_Service/Version_2#inc.3D_arguments_which_affect_subqueries
-Not all of them are supported yet.
+Not all of them are supported yet. The constants of type IncludeOption e.g.
+IncAliases cover all valid inc params.
 
 
 Browse requets
 
-not supported yet.
+Browse requests return all entities of one type that are linked to another
+entity, e.g. all releases of an artist. GoMusicBrainz implements one browse
+method for every entity that can be browsed in the form:
+
+	func (*WS2Client) Browse<ENTITY>s(entity string, id MBID, limit, offset int, inc ...IncludeOption) (<ENTITY>SearchResponse, error)
+
+entity is the type of the linked entity (e.g. "artist") and id its MBID. limit
+and offset work like WithLimit and WithOffset above, set them to -1 to ignore
+them. inc works the same way as described above.
+
+
+Cancellation
+
+Every request method has a counterpart with the suffix Context that takes a
+context.Context as its first argument, e.g.
+
+	func (*WS2Client) SearchArtistContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*ArtistSearchResponse, error)
+
+The request is aborted as soon as ctx is done, including while waiting for the
+rate limiter or a retry. The methods without the suffix use
+context.Background().
 
 */
 package gomusicbrainz
 
 import (
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"path"
 	"strconv"
 	"strings"
+	"time"
 )
 
-// NewWS2Client returns a new instance of WS2Client. Please provide meaningful
-// information about your application as described at
+// DefaultRootURL is the WS2 root URL used by NewWS2Client if WithRootURL is not
+// given.
+const DefaultRootURL = "https://musicbrainz.org/ws/2"
+
+// NewWS2Client returns a new instance of WS2Client configured by the given
+// options e.g.
+//
+//	client, err := gomusicbrainz.NewWS2Client(
+//		gomusicbrainz.WithAppInfo("A GoMusicBrainz example", "0.0.1-beta", "http://github.com/michiwend/gomusicbrainz"),
+//	)
+//
+// WithAppInfo is mandatory. Please provide meaningful information about your
+// application as described at
 // https://musicbrainz.org/doc/XML_Web_Service/Rate_Limiting#Provide_meaningful_User-Agent_strings
-func NewWS2Client(wsurl, appname, version, contact string) (*WS2Client, error) {
-	c := WS2Client{}
-	var err error
+func NewWS2Client(opts ...Option) (*WS2Client, error) {
+	c := WS2Client{
+		Timeout:    DefaultTimeout,
+		MaxRetries: DefaultMaxRetries,
+		limiter:    newRateLimiter(DefaultRequestsPerSecond),
+		flights:    newFlightGroup(),
+	}
 
-	c.WS2RootURL, err = url.Parse(wsurl)
-	if err != nil {
+	if err := setRootURL(&c, DefaultRootURL); err != nil {
 		return nil, err
 	}
-	if !strings.HasSuffix(c.WS2RootURL.Path, "ws/2") {
-		c.WS2RootURL.Path = path.Join(c.WS2RootURL.Path, "ws/2")
+
+	for _, opt := range opts {
+		if err := opt(&c); err != nil {
+			return nil, err
+		}
+	}
+
+	if c.userAgentHeader == "" {
+		return nil, errors.New("no application info given, use WithAppInfo.")
 	}
-	c.userAgentHeader = appname + "/" + version + " ( " + contact + " ) "
 
 	return &c, nil
 }
 
+func setRootURL(c *WS2Client, wsurl string) error {
+	u, err := url.Parse(wsurl)
+	if err != nil {
+		return err
+	}
+	if !strings.HasSuffix(u.Path, "ws/2") {
+		u.Path = path.Join(u.Path, "ws/2")
+	}
+	c.WS2RootURL = u
+	return nil
+}
+
+// DefaultTimeout is the request timeout applied by NewWS2Client.
+const DefaultTimeout = 30 * time.Second
+
+// DefaultMaxRetries is the number of retries applied by NewWS2Client.
+const DefaultMaxRetries = 3
+
+// retryBaseDelay is the delay before the first retry if WS2 does not send a
+// Retry-After header. It doubles with every further retry.
+const retryBaseDelay = time.Second
+
 // WS2Client defines a Go client for the MusicBrainz Web Service 2.
 type WS2Client struct {
-	WS2RootURL      *url.URL // The API root URL
+	WS2RootURL      *url.URL      // The API root URL
+	Timeout         time.Duration // Time limit for each request, zero means no timeout
+	HTTPClient      *http.Client  // Used for requests if set, Timeout is ignored then
+	MaxRetries      int           // Number of retries if WS2 responds with HTTP status 503
 	userAgentHeader string
+	limiter         *rateLimiter
+	cache           Cache
+	cacheTTL        time.Duration
+	interceptors    []Interceptor
+	metrics         *metricsCollector
+	flights         *flightGroup
+}
+
+// SetAppInfo sets the name, version and contact (URL or e-mail) of your
+// application which are sent as User-Agent with every request.
+func (c *WS2Client) SetAppInfo(appname, version, contact string) {
+	c.userAgentHeader = appname + "/" + version + " ( " + contact + " ) "
+}
+
+// SetMaxRetries sets how often a request is retried if WS2 responds with HTTP
+// status 503 Service Unavailable, which happens when the rate limit is
+// exceeded. After the last retry a *RateLimitError is returned.
+func (c *WS2Client) SetMaxRetries(n int) {
+	c.MaxRetries = n
+}
+
+// SetHTTPClient sets the http.Client used to perform requests e.g. to use a
+// custom transport or proxy. The Timeout of the client is ignored if an
+// http.Client is set, configure it on the http.Client instead. Passing nil
+// restores the default client.
+func (c *WS2Client) SetHTTPClient(client *http.Client) {
+	c.HTTPClient = client
+}
+
+// SetTimeout sets the time limit for each request made by the client. A
+// timeout of zero means no timeout.
+func (c *WS2Client) SetTimeout(d time.Duration) {
+	c.Timeout = d
+}
+
+// SetRateLimit sets the maximum number of requests per second the client
+// sends to WS2. NewWS2Client defaults to DefaultRequestsPerSecond, a value
+// <= 0 disables rate limiting e.g. for your own MusicBrainz mirror.
+func (c *WS2Client) SetRateLimit(requestsPerSecond float64) {
+	if c.limiter == nil {
+		c.limiter = newRateLimiter(requestsPerSecond)
+		return
+	}
+	c.limiter.setRate(requestsPerSecond)
+}
+
+// Clone returns a copy of c which can be customized e.g. with SetTimeout or
+// SetAppInfo without affecting c. The clone gets its own rate limiter with
+// the same rate, its own copy of the HTTPClient, if set, and starts with empty
+// metrics. The cache is shared with c.
+func (c *WS2Client) Clone() *WS2Client {
+	clone := *c
+
+	if c.WS2RootURL != nil {
+		u := *c.WS2RootURL
+		clone.WS2RootURL = &u
+	}
+	if c.HTTPClient != nil {
+		hc := *c.HTTPClient
+		clone.HTTPClient = &hc
+	}
+	if c.limiter != nil {
+		clone.limiter = c.limiter.clone()
+	}
+	clone.interceptors = append([]Interceptor(nil), c.interceptors...)
+	if c.flights != nil {
+		clone.flights = newFlightGroup()
+	}
+	if c.metrics != nil {
+		clone.metrics = newMetricsCollector()
+		for i, ic := range clone.interceptors {
+			if ic == Interceptor(c.metrics) {
+				clone.interceptors[i] = clone.metrics
+			}
+		}
+	}
+
+	return &clone
 }
 
-func (c *WS2Client) getRequest(data interface{}, params url.Values, endpoint string) error {
+// ClearCache removes all cached responses. It has no effect if no cache was
+// enabled or the cache set with WithCacheBackend has no Clear method.
+func (c *WS2Client) ClearCache() {
+	if cl, ok := c.cache.(interface {
+		Clear()
+	}); ok {
+		cl.Clear()
+	}
+}
+
+// httpClient returns the HTTPClient of c or, if not set, a new http.Client
+// that respects c.Timeout.
+func (c *WS2Client) httpClient() *http.Client {
 
-	client := &http.Client{}
+	if c.HTTPClient != nil {
+		return c.HTTPClient
+	}
+
+	client := &http.Client{Timeout: c.Timeout}
 
 	defaultRedirectLimit := 30
 
@@ -130,27 +295,270 @@ func (c *WS2Client) getRequest(data interface{}, params url.Values, endpoint str
 		return nil
 	}
 
+	return client
+}
+
+// retryDelay returns how long to wait before retrying a request that was
+// answered with resp. It honors the Retry-After header and falls back to an
+// exponential backoff based on the number of the failed attempt.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	if d, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		return d
+	}
+	return retryBaseDelay << uint(attempt)
+}
+
+// parseRetryAfter parses the value of a Retry-After header which is either a
+// number of seconds or an HTTP-date. A date is converted to the duration from
+// now, dates in the past result in 0. ok is false if the value is missing or
+// malformed.
+func parseRetryAfter(value string, now time.Time) (d time.Duration, ok bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(value); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d = t.Sub(now); d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+// do sends req and retries it up to c.MaxRetries times as long as WS2 responds
+// with HTTP status 503 Service Unavailable which indicates that the rate limit
+// was exceeded. Waiting for the rate limiter or a retry is aborted once the
+// context of req is done.
+func (c *WS2Client) do(req *http.Request) (*http.Response, error) {
+
+	client := c.httpClient()
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+
+		if c.limiter != nil {
+			if err := c.limiter.wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		resp, err := c.roundTrip(client, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if resp.StatusCode != http.StatusServiceUnavailable {
+			return resp, nil
+		}
+		resp.Body.Close()
+
+		if attempt >= c.MaxRetries {
+			retryAfter, _ := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+			return nil, &RateLimitError{
+				URL:        req.URL.String(),
+				Retries:    attempt,
+				RetryAfter: retryAfter,
+			}
+		}
+
+		if err := sleepContext(ctx, retryDelay(resp, attempt)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// sleepContext pauses for d or until ctx is done, whichever happens first. In
+// the latter case ctx.Err() is returned.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// getRequest requests endpoint with params and decodes the response into
+// data. Concurrent requests of the same URL are coalesced into a single
+// request whose response is decoded for every caller.
+func (c *WS2Client) getRequest(ctx context.Context, data interface{}, params url.Values, endpoint string) error {
+
+	reqUrl := c.requestURL(endpoint, params)
+	key := reqUrl.String()
+
+	// fetchAndDecode only caches responses that could be decoded
+	fetchAndDecode := func() ([]byte, error) {
+		body, header, err := c.fetch(ctx, &reqUrl)
+		if err != nil {
+			return nil, err
+		}
+		if err := decodeResponse(key, body, data); err != nil {
+			return nil, err
+		}
+		if header != nil && c.cache != nil {
+			c.cacheResponse(key, body, header)
+		}
+		return body, nil
+	}
+
+	if c.flights == nil {
+		_, err := fetchAndDecode()
+		return err
+	}
+
+	for {
+		body, shared, err := c.flights.do(ctx, key, fetchAndDecode)
+		if !shared {
+			return err
+		}
+		// the shared call was aborted by the context of another caller
+		if isContextError(err) && ctx.Err() == nil {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return decodeResponse(key, body, data)
+	}
+}
+
+// isContextError reports whether err was caused by a done context.
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// requestURL returns the URL of a request to endpoint with params.
+func (c *WS2Client) requestURL(endpoint string, params url.Values) url.URL {
 	reqUrl := *c.WS2RootURL
 	reqUrl.Path = path.Join(reqUrl.Path, endpoint)
 	reqUrl.RawQuery = params.Encode()
+	return reqUrl
+}
 
-	req, err := http.NewRequest("GET", reqUrl.String(), nil)
+// fetch returns the body of a successful response to a GET request of reqUrl,
+// served from the cache if possible. header is only set for a new response
+// which is yet to be cached.
+func (c *WS2Client) fetch(ctx context.Context, reqUrl *url.URL) (body []byte, header http.Header, err error) {
+
+	// stale entries are kept to revalidate them with a conditional request
+	var cached *cacheEntry
+	if c.cache != nil {
+		if v, ok := c.cache.Get(reqUrl.String()); ok {
+			if e, ok := unmarshalCacheEntry(v); ok {
+				if e.fresh() {
+					return e.body, nil, nil
+				}
+				cached = e
+			}
+		}
+	}
+
+	return c.send(ctx, reqUrl, cached)
+}
+
+// send performs a GET request of reqUrl and returns the body of a successful
+// response. If cached is not nil the request is conditional and the cached
+// body is returned if it is still valid. header is only set for a new
+// response.
+func (c *WS2Client) send(ctx context.Context, reqUrl *url.URL, cached *cacheEntry) (body []byte, header http.Header, err error) {
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqUrl.String(), nil)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
 	req.Header.Set("User-Agent", c.userAgentHeader)
+	if cached != nil {
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
 
-	resp, err := client.Do(req)
+	resp, err := c.do(req)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
-	decoder := xml.NewDecoder(resp.Body)
+	if resp.StatusCode == http.StatusNotModified && cached != nil {
+		// a 304 response may omit unchanged validators
+		if resp.Header.Get("ETag") == "" {
+			resp.Header.Set("ETag", cached.etag)
+		}
+		if resp.Header.Get("Last-Modified") == "" {
+			resp.Header.Set("Last-Modified", cached.lastModified)
+		}
+		c.cacheResponse(reqUrl.String(), cached.body, resp.Header)
+		return cached.body, nil, nil
+	}
 
-	if err = decoder.Decode(data); err != nil {
-		return err
+	if err := checkResponse(reqUrl.String(), resp); err != nil {
+		return nil, nil, err
+	}
+
+	if body, err = ioutil.ReadAll(resp.Body); err != nil {
+		return nil, nil, err
+	}
+	return body, resp.Header, nil
+}
+
+// checkResponse returns a typed error if resp is not a successful WS2
+// response so error documents are never decoded as entities.
+func checkResponse(reqUrl string, resp *http.Response) error {
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return &NotFoundError{URL: reqUrl}
+	case http.StatusBadRequest:
+		return &BadRequestError{URL: reqUrl, Message: wsErrorMessage(resp.Body)}
+	case http.StatusUnauthorized:
+		return &AuthRequiredError{URL: reqUrl, Message: wsErrorMessage(resp.Body)}
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return &StatusError{
+			URL:        reqUrl,
+			StatusCode: resp.StatusCode,
+			Message:    wsErrorMessage(resp.Body),
+		}
+	}
+	return nil
+}
+
+// cacheResponse stores body and the validators contained in header in the
+// cache. Entries that can be revalidated are stored without ttl so they
+// outlive their freshness.
+func (c *WS2Client) cacheResponse(key string, body []byte, header http.Header) {
+	e := newCacheEntry(body, header, c.cacheTTL)
+
+	ttl := c.cacheTTL
+	if e.revalidatable() {
+		ttl = 0
+	}
+	c.cache.Set(key, e.marshal(), ttl)
+}
+
+// decodeResponse decodes the WS2 response body of the request to reqUrl into
+// data.
+func decodeResponse(reqUrl string, body []byte, data interface{}) error {
+	if err := xml.Unmarshal(body, data); err != nil {
+		return &XMLDecodeError{URL: reqUrl, Err: err}
 	}
 	return nil
 }
@@ -163,38 +571,68 @@ func intParamToString(i int) string {
 	return strconv.Itoa(i)
 }
 
-func (c *WS2Client) searchRequest(endpoint string, result interface{}, searchTerm string, limit, offset int) error {
+func (c *WS2Client) searchRequest(ctx context.Context, endpoint string, result interface{}, searchTerm string, opts []SearchOption) error {
 
+	o := newSearchOptions(opts)
 	params := url.Values{
 		"query":  {searchTerm},
-		"limit":  {intParamToString(limit)},
-		"offset": {intParamToString(offset)},
+		"limit":  {intParamToString(o.Limit)},
+		"offset": {intParamToString(o.Offset)},
 	}
 
-	if err := c.getRequest(result, params, endpoint); err != nil {
+	if err := c.getRequest(ctx, result, params, endpoint); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func encodeInc(inc []string) url.Values {
+// browseRequest performs a browse request for entities linked to the entity
+// with the given id. filter can contain additional params e.g. to filter
+// by type.
+func (c *WS2Client) browseRequest(ctx context.Context, endpoint string, result interface{}, entity string, id MBID, filter url.Values, limit, offset int, inc []IncludeOption) error {
+
+	params := url.Values{
+		entity:   {string(id)},
+		"limit":  {intParamToString(limit)},
+		"offset": {intParamToString(offset)},
+	}
+	for k, v := range filter {
+		params[k] = v
+	}
+	if inc != nil {
+		params.Set("inc", joinInc(inc))
+	}
+
+	return c.getRequest(ctx, result, params, endpoint)
+}
+
+func encodeInc(inc []IncludeOption) url.Values {
 	if inc != nil {
 		return url.Values{
-			"inc": {strings.Join(inc, "+")},
+			"inc": {joinInc(inc)},
 		}
 	}
 	return nil
 }
 
 // Lookup performs a WS2 lookup request for the given entity (e.g. Artist,
-// Label, ...)
-func (c *WS2Client) Lookup(entity MBLookupEntity, inc ...string) error {
+// Label, ...). A *NotFoundError is returned if no entity with the given MBID
+// exists.
+func (c *WS2Client) Lookup(entity MBLookupEntity, inc ...IncludeOption) error {
+	return c.LookupContext(context.Background(), entity, inc...)
+}
+
+// LookupContext is like Lookup but aborts the request once ctx is done.
+func (c *WS2Client) LookupContext(ctx context.Context, entity MBLookupEntity, inc ...IncludeOption) error {
 	if entity.Id() == "" {
 		return errors.New("can't perform lookup without ID.")
 	}
+	if !entity.Id().Valid() {
+		return fmt.Errorf("can't perform lookup with malformed ID %q.", entity.Id())
+	}
 
-	return c.getRequest(entity.lookupResult(), encodeInc(inc),
+	return c.getRequest(ctx, entity.lookupResult(), encodeInc(inc),
 		path.Join(
 			entity.apiEndpoint(),
 			string(entity.Id()),
@@ -28,11 +28,15 @@ package gomusicbrainz
 // TODO use testdata from https://github.com/metabrainz/mmd-schema/tree/master/test-data/valid
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/michiwend/golang-pretty"
 )
@@ -49,10 +53,10 @@ func setupHTTPTesting() {
 	server = httptest.NewServer(mux)
 
 	client, _ = NewWS2Client(
-		server.URL,
-		"Application Name",
-		"Version",
-		"Contact",
+		WithRootURL(server.URL),
+		WithAppInfo("Application Name", "Version", "Contact"),
+		// no need to be nice to the test server
+		WithRateLimit(0),
 	)
 
 	// NOTE this fixes testing since the test server does not listen on /ws/2
@@ -73,6 +77,27 @@ func serveTestFile(endpoint string, testfile string, t *testing.T) {
 	})
 }
 
+// serveTestFileWithParams works like serveTestFile but additionally checks
+// that the request contains the given query params.
+func serveTestFileWithParams(endpoint string, testfile string, params url.Values, t *testing.T) {
+
+	t.Log("Handling endpoint", endpoint)
+	t.Log("Serving test file", testfile)
+
+	mux.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+		t.Log("GET request was:", r.URL.String())
+
+		query := r.URL.Query()
+		for k := range params {
+			if query.Get(k) != params.Get(k) {
+				t.Errorf("param %s: want %q, got %q", k, params.Get(k), query.Get(k))
+			}
+		}
+
+		http.ServeFile(w, r, path.Join("./testdata", testfile))
+	})
+}
+
 // pretty prints a diff
 func requestDiff(want, returned interface{}) string {
 
@@ -83,3 +108,377 @@ func requestDiff(want, returned interface{}) string {
 	}
 	return out
 }
+
+// mustPartialDate parses s or panics.
+func mustPartialDate(s string) PartialDate {
+	p, err := ParsePartialDate(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+func TestRateLimit(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/artist", "SearchArtist.xml", t)
+
+	client.SetRateLimit(20)
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		if _, err := client.SearchArtist("Gopher"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if elapsed := time.Since(start); elapsed < 100*time.Millisecond {
+		t.Errorf("3 requests at 20 req/s took only %v", elapsed)
+	}
+}
+
+func TestTimeout(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	})
+
+	client.SetTimeout(50 * time.Millisecond)
+
+	if _, err := client.SearchArtist("Gopher"); err == nil {
+		t.Error("expected timeout error")
+	}
+}
+
+type countingTransport struct {
+	count int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.count++
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestSetHTTPClient(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/artist", "SearchArtist.xml", t)
+
+	transport := &countingTransport{}
+	client.SetHTTPClient(&http.Client{Transport: transport})
+
+	if _, err := client.SearchArtist("Gopher"); err != nil {
+		t.Error(err)
+	}
+
+	if transport.count != 1 {
+		t.Errorf("expected 1 request through custom client, got %d", transport.count)
+	}
+}
+
+func TestNewWS2Client(t *testing.T) {
+
+	c, err := NewWS2Client(WithAppInfo("Application Name", "Version", "Contact"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := c.WS2RootURL.String(); got != DefaultRootURL {
+		t.Errorf("root URL: want %s, got %s", DefaultRootURL, got)
+	}
+	if c.Timeout != DefaultTimeout {
+		t.Errorf("timeout: want %v, got %v", DefaultTimeout, c.Timeout)
+	}
+
+	c, err = NewWS2Client(
+		WithRootURL("http://localhost:5000"),
+		WithAppInfo("Application Name", "Version", "Contact"),
+		WithTimeout(time.Second),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := c.WS2RootURL.String(); got != "http://localhost:5000/ws/2" {
+		t.Errorf("root URL: want http://localhost:5000/ws/2, got %s", got)
+	}
+	if c.Timeout != time.Second {
+		t.Errorf("timeout: want %v, got %v", time.Second, c.Timeout)
+	}
+
+	if _, err := NewWS2Client(); err == nil {
+		t.Error("expected error without app info")
+	}
+}
+
+func TestRetryOnServiceUnavailable(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	requests := 0
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		http.ServeFile(w, r, "./testdata/SearchArtist.xml")
+	})
+
+	client.SetMaxRetries(2)
+
+	returned, err := client.SearchArtist("Gopher")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(returned.Artists) != 1 {
+		t.Errorf("expected 1 artist, got %d", len(returned.Artists))
+	}
+	if requests != 3 {
+		t.Errorf("expected 3 requests, got %d", requests)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	requests := 0
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	client.SetMaxRetries(1)
+
+	_, err := client.SearchArtist("Gopher")
+	if e, ok := err.(*RateLimitError); !ok || e.Retries != 1 {
+		t.Errorf("expected *RateLimitError after 1 retry, got %#v", err)
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestRateLimitErrorRetryAfter(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "120")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	client.SetMaxRetries(0)
+
+	_, err := client.SearchArtist("Gopher")
+	if e, ok := err.(*RateLimitError); !ok || e.RetryAfter != 2*time.Minute {
+		t.Errorf("expected *RateLimitError with RetryAfter 2m, got %#v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2015, time.October, 21, 7, 28, 0, 0, time.UTC)
+
+	tests := []struct {
+		value string
+		want  time.Duration
+		ok    bool
+	}{
+		{"", 0, false},
+		{"0", 0, true},
+		{" 30 ", 30 * time.Second, true},
+		{"-1", 0, false},
+		{"Wed, 21 Oct 2015 07:29:30 GMT", 90 * time.Second, true},
+		{"Wed, 21 Oct 2015 07:00:00 GMT", 0, true},
+		{"soon", 0, false},
+	}
+
+	for _, test := range tests {
+		if d, ok := parseRetryAfter(test.value, now); d != test.want || ok != test.ok {
+			t.Errorf("%q: want %v, %v, got %v, %v", test.value, test.want, test.ok, d, ok)
+		}
+	}
+}
+
+func TestRequestErrorsAreReturned(t *testing.T) {
+
+	setupHTTPTesting()
+	// close the server right away so that the request fails
+	server.Close()
+
+	if _, err := client.SearchArtist("Gopher"); err == nil {
+		t.Error("expected error from unreachable server")
+	}
+
+	client.WS2RootURL.Host = "invalid host"
+
+	if _, err := client.SearchArtist("Gopher"); err == nil {
+		t.Error("expected error from invalid request URL")
+	}
+}
+
+func TestContextCanceled(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	requests := 0
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.SearchArtistContext(ctx, "Gopher"); err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if requests != 0 {
+		t.Errorf("expected no request to be sent, got %d", requests)
+	}
+}
+
+func TestContextAbortsRetry(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := client.LookupArtistContext(ctx, "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8"); err != context.DeadlineExceeded {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("retry was not aborted, took %v", elapsed)
+	}
+}
+
+func TestBadRequestError(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><error><text>Invalid query.</text><text>For usage, please see: https://musicbrainz.org/development/mmd</text></error>`)
+	})
+
+	_, err := client.SearchArtist("Gopher")
+
+	var e *BadRequestError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *BadRequestError, got %#v", err)
+	}
+	if e.Message != "Invalid query." {
+		t.Errorf("expected message %q, got %q", "Invalid query.", e.Message)
+	}
+}
+
+func TestAuthRequiredError(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	_, err := client.SearchArtist("Gopher")
+
+	var e *AuthRequiredError
+	if !errors.As(err, &e) {
+		t.Errorf("expected *AuthRequiredError, got %#v", err)
+	}
+}
+
+func TestXMLDecodeError(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<metadata><artist-list>`)
+	})
+
+	_, err := client.SearchArtist("Gopher")
+
+	var e *XMLDecodeError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *XMLDecodeError, got %#v", err)
+	}
+	if e.Err == nil {
+		t.Error("expected decoder error to be set")
+	}
+}
+
+func TestStatusError(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><error><text>Internal server error.</text></error>`)
+	})
+
+	_, err := client.SearchArtist("Gopher")
+
+	var e *StatusError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected *StatusError, got %#v", err)
+	}
+	if e.StatusCode != http.StatusInternalServerError {
+		t.Errorf("expected status %d, got %d", http.StatusInternalServerError, e.StatusCode)
+	}
+	if e.Message != "Internal server error." {
+		t.Errorf("expected message %q, got %q", "Internal server error.", e.Message)
+	}
+}
+
+func TestClone(t *testing.T) {
+
+	c, err := NewWS2Client(
+		WithAppInfo("Application Name", "Version", "Contact"),
+		WithHTTPClient(&http.Client{Timeout: time.Second}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	clone := c.Clone()
+	clone.SetAppInfo("Other Name", "Version", "Contact")
+	clone.SetRateLimit(10)
+	clone.HTTPClient.Timeout = time.Minute
+	clone.WS2RootURL.Host = "mirror.example.org"
+
+	if c.userAgentHeader == clone.userAgentHeader {
+		t.Error("SetAppInfo on the clone changed the original")
+	}
+	if c.limiter == clone.limiter || c.limiter.interval != time.Second {
+		t.Error("the clone shares its rate limiter with the original")
+	}
+	if c.HTTPClient.Timeout != time.Second {
+		t.Error("the clone shares its HTTPClient with the original")
+	}
+	if c.WS2RootURL.Host != "musicbrainz.org" {
+		t.Error("the clone shares its root URL with the original")
+	}
+}
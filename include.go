@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import "strings"
+
+// IncludeOption is an inc param of a lookup or browse request that affects
+// which subqueries are included in the response e.g. aliases or relations.
+// Not every option is valid for every entity, see the doc of the respective
+// lookup method and
+// https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2#inc.3D_arguments_which_affect_subqueries
+type IncludeOption string
+
+// Subqueries for entities linked to the requested entity.
+const (
+	IncArtists        IncludeOption = "artists"
+	IncCollections    IncludeOption = "collections"
+	IncLabels         IncludeOption = "labels"
+	IncRecordings     IncludeOption = "recordings"
+	IncReleases       IncludeOption = "releases"
+	IncReleaseGroups  IncludeOption = "release-groups"
+	IncWorks          IncludeOption = "works"
+	IncVariousArtists IncludeOption = "various-artists"
+)
+
+// Additional information about the requested entity.
+const (
+	IncAliases       IncludeOption = "aliases"
+	IncAnnotation    IncludeOption = "annotation"
+	IncArtistCredits IncludeOption = "artist-credits"
+	IncDiscIDs       IncludeOption = "discids"
+	IncGenres        IncludeOption = "genres"
+	IncISRCs         IncludeOption = "isrcs"
+	IncMedia         IncludeOption = "media"
+	IncRatings       IncludeOption = "ratings"
+	IncTags          IncludeOption = "tags"
+	IncUserGenres    IncludeOption = "user-genres"
+	IncUserRatings   IncludeOption = "user-ratings"
+	IncUserTags      IncludeOption = "user-tags"
+)
+
+// Relationships of the requested entity to entities of a given type.
+const (
+	IncAreaRels         IncludeOption = "area-rels"
+	IncArtistRels       IncludeOption = "artist-rels"
+	IncEventRels        IncludeOption = "event-rels"
+	IncInstrumentRels   IncludeOption = "instrument-rels"
+	IncLabelRels        IncludeOption = "label-rels"
+	IncPlaceRels        IncludeOption = "place-rels"
+	IncRecordingRels    IncludeOption = "recording-rels"
+	IncReleaseRels      IncludeOption = "release-rels"
+	IncReleaseGroupRels IncludeOption = "release-group-rels"
+	IncSeriesRels       IncludeOption = "series-rels"
+	IncURLRels          IncludeOption = "url-rels"
+	IncWorkRels         IncludeOption = "work-rels"
+
+	// Relationships of recordings, release groups and works included in
+	// the response, e.g. of the recordings on a release.
+	IncRecordingLevelRels    IncludeOption = "recording-level-rels"
+	IncReleaseGroupLevelRels IncludeOption = "release-group-level-rels"
+	IncWorkLevelRels         IncludeOption = "work-level-rels"
+)
+
+// joinInc joins inc to the format expected by WS2 e.g. "aliases+tags".
+func joinInc(inc []IncludeOption) string {
+	s := make([]string, len(inc))
+	for i, v := range inc {
+		s[i] = string(v)
+	}
+	return strings.Join(s, "+")
+}
@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"encoding/xml"
+	"strings"
+)
+
+// Instrument represents a device created or adapted to make musical sounds.
+// See https://musicbrainz.org/doc/Instrument
+type Instrument struct {
+	ID             MBID               `xml:"id,attr" json:"id,omitempty"`
+	Type           InstrumentType     `xml:"type,attr" json:"type,omitempty"`
+	Name           string             `xml:"name" json:"name,omitempty"`
+	Disambiguation string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	Description    string             `xml:"description" json:"description,omitempty"`
+	Aliases        []*Alias           `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Tags           []Tag              `xml:"tag-list>tag" json:"tags,omitempty"`
+	Genres         []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Annotation     string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations      TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
+}
+
+// InstrumentType describes the family of an Instrument. See
+// https://musicbrainz.org/doc/Instrument#Type
+type InstrumentType string
+
+const (
+	InstrumentTypeWind       InstrumentType = "Wind instrument"
+	InstrumentTypeString     InstrumentType = "String instrument"
+	InstrumentTypePercussion InstrumentType = "Percussion instrument"
+	InstrumentTypeElectronic InstrumentType = "Electronic instrument"
+	InstrumentTypeFamily     InstrumentType = "Family"
+	InstrumentTypeEnsemble   InstrumentType = "Ensemble"
+	InstrumentTypeOther      InstrumentType = "Other"
+)
+
+// MIMOURL returns the URL of the instrument in the MIMO (Musical Instrument
+// Museums Online) database or an empty string if there is none. It requires
+// the instrument to be looked up with url-rels.
+func (mbe *Instrument) MIMOURL() string {
+	for _, rel := range mbe.Relations["url"] {
+		if r, ok := rel.(*URLRelation); ok &&
+			strings.Contains(strings.ToLower(r.Target), "mimo-international.com") {
+			return r.Target
+		}
+	}
+	return ""
+}
+
+func (mbe *Instrument) lookupResult() interface{} {
+	var res struct {
+		XMLName xml.Name    `xml:"metadata"`
+		Ptr     *Instrument `xml:"instrument"`
+	}
+	res.Ptr = mbe
+	return &res
+}
+
+func (mbe *Instrument) apiEndpoint() string {
+	return "/instrument"
+}
+
+func (mbe *Instrument) Id() MBID {
+	return mbe.ID
+}
+
+// LookupInstrument performs an instrument lookup request for the given MBID.
+//
+// Possible inc params are aliases, tags, genres, annotation and <ENTITY>-rels
+// e.g. instrument-rels or url-rels.
+func (c *WS2Client) LookupInstrument(id MBID, inc ...IncludeOption) (*Instrument, error) {
+	return c.LookupInstrumentContext(context.Background(), id, inc...)
+}
+
+// LookupInstrumentContext is like LookupInstrument but aborts the request once ctx is done.
+func (c *WS2Client) LookupInstrumentContext(ctx context.Context, id MBID, inc ...IncludeOption) (*Instrument, error) {
+	a := &Instrument{ID: id}
+	err := c.LookupContext(ctx, a, inc...)
+
+	return a, err
+}
+
+// SearchInstrument queries MusicBrainz´ Search Server for Instruments.
+//
+// Possible search fields to provide in searchTerm are:
+//
+//	alias             the aliases/misspellings for the instrument
+//	comment           disambiguation comment
+//	description       description of the instrument
+//	iid               MBID of the instrument
+//	instrument        name of the instrument
+//	instrumentaccent  name of the instrument with any accent characters retained
+//	tag               folksonomy tag
+//	type              instrument type (e.g. wind instrument)
+//
+// With no fields specified searchTerm searches the instrument, alias and
+// description fields. For more information visit
+// https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Instrument
+func (c *WS2Client) SearchInstrument(searchTerm string, opts ...SearchOption) (*InstrumentSearchResponse, error) {
+	return c.SearchInstrumentContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchInstrumentContext is like SearchInstrument but aborts the request once ctx is done.
+func (c *WS2Client) SearchInstrumentContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*InstrumentSearchResponse, error) {
+
+	result := instrumentListResult{}
+	err := c.searchRequest(ctx, "/instrument", &result, searchTerm, opts)
+
+	rsp := InstrumentSearchResponse{}
+	rsp.WS2ListResponse = result.InstrumentList.WS2ListResponse
+	rsp.Scores = make(ScoreMap)
+
+	for i, v := range result.InstrumentList.Instruments {
+		rsp.Instruments = append(rsp.Instruments, v.Instrument)
+		rsp.Scores[rsp.Instruments[i]] = v.Score
+	}
+
+	return &rsp, err
+}
+
+// InstrumentSearchResponse is the response type returned by the
+// SearchInstrument method.
+type InstrumentSearchResponse struct {
+	WS2ListResponse
+	Instruments []*Instrument `json:"instruments,omitempty"`
+	Scores      ScoreMap      `json:"-"`
+}
+
+// HasMore reports whether there are instruments after the ones in r.
+func (r *InstrumentSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Instruments))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *InstrumentSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Instruments))
+}
+
+// ResultsWithScore returns a slice of Instruments with a min score.
+func (r *InstrumentSearchResponse) ResultsWithScore(score int) []*Instrument {
+	var res []*Instrument
+	for _, v := range r.Instruments {
+		if r.Scores[v] >= score {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+type instrumentListResult struct {
+	InstrumentList struct {
+		WS2ListResponse
+		Instruments []struct {
+			*Instrument
+			Score int `xml:"http://musicbrainz.org/ns/ext#-2.0 score,attr"`
+		} `xml:"instrument"`
+	} `xml:"instrument-list"`
+}
@@ -0,0 +1,157 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchInstrument(t *testing.T) {
+
+	want := InstrumentSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		Instruments: []*Instrument{
+			{
+				ID:          "63021302-86cd-4aee-80df-2270d54f4978",
+				Type:        "String instrument",
+				Name:        "guitar",
+				Description: "Considered the most popular instrument in the world.",
+				Aliases: []*Alias{
+					{
+						Name:     "Gitarre",
+						SortName: "Gitarre",
+						Locale:   "de",
+						Type:     "Instrument name",
+						Primary:  true,
+					},
+				},
+				Tags: []Tag{
+					{
+						Count: 1,
+						Name:  "plucked",
+					},
+				},
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/instrument", "SearchInstrument.xml", t)
+
+	returned, err := client.SearchInstrument("guitar")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want.Scores = ScoreMap{
+		returned.Instruments[0]: 100,
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupInstrument(t *testing.T) {
+
+	want := Instrument{
+		ID:          "63021302-86cd-4aee-80df-2270d54f4978",
+		Type:        InstrumentTypeString,
+		Name:        "guitar",
+		Description: "Considered the most popular instrument in the world.",
+		Relations: TargetRelationsMap{
+			"instrument": []Relation{
+				&InstrumentRelation{
+					RelationAbstract: RelationAbstract{
+						TypeID:    "40b2bd3f-1457-3ceb-810d-57f87f0f74f0",
+						Type:      "type of",
+						Target:    "21bd4d63-a75a-4022-abd3-52ba7487c2de",
+						Direction: "backward",
+					},
+					Instrument: Instrument{
+						ID:   "21bd4d63-a75a-4022-abd3-52ba7487c2de",
+						Type: "String instrument",
+						Name: "acoustic guitar",
+					},
+				},
+			},
+			"url": []Relation{
+				&URLRelation{
+					RelationAbstract: RelationAbstract{
+						TypeID:   "0e62afec-12f3-3d0f-b122-956207839854",
+						Type:     "wikidata",
+						Target:   "https://www.wikidata.org/wiki/Q6607",
+						TargetID: "3b6e5a4e-3d9a-4a9b-9c9d-3c4a0c0c3a52",
+					},
+				},
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile(
+		"/instrument/63021302-86cd-4aee-80df-2270d54f4978",
+		"LookupInstrument.xml", t)
+
+	returned, err := client.LookupInstrument(
+		"63021302-86cd-4aee-80df-2270d54f4978",
+		"instrument-rels",
+		"url-rels")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestInstrumentMIMOURL(t *testing.T) {
+
+	mimo := "http://www.mimo-international.com/MIMO/doc/IFD/OAI_IMP_MIMO_CIMCIM_HS_7"
+	inst := Instrument{
+		Relations: TargetRelationsMap{
+			"url": []Relation{
+				&URLRelation{RelationAbstract{Type: "wikidata", Target: "https://www.wikidata.org/wiki/Q6607"}},
+				&URLRelation{RelationAbstract{Type: "other databases", Target: mimo}},
+			},
+		},
+	}
+
+	if got := inst.MIMOURL(); got != mimo {
+		t.Errorf("got %q, want %q", got, mimo)
+	}
+	if got := (&Instrument{}).MIMOURL(); got != "" {
+		t.Errorf("got %q, want empty string", got)
+	}
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"log"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Interceptor can inspect and modify each HTTP request sent to WS2 and
+// inspect its response e.g. for logging, metrics or request signing.
+// Interceptors are called for every attempt of a request including retries
+// but not for responses served from the cache.
+type Interceptor interface {
+	// Before is called before req is sent. Returning an error aborts the
+	// request with that error.
+	Before(req *http.Request) error
+	// After is called once the response to req was received or sending it
+	// failed with err. The body of resp must not be read.
+	After(req *http.Request, resp *http.Response, err error)
+}
+
+// AddInterceptor adds i to the interceptors of the client. Interceptors are
+// called in the order they were added. AddInterceptor must not be called
+// concurrently with requests.
+func (c *WS2Client) AddInterceptor(i Interceptor) {
+	c.interceptors = append(c.interceptors, i)
+}
+
+// roundTrip sends req with client and calls the interceptors of c. If Before
+// of an interceptor fails, After is called with that error for all
+// interceptors whose Before already succeeded.
+func (c *WS2Client) roundTrip(client *http.Client, req *http.Request) (*http.Response, error) {
+	for n, i := range c.interceptors {
+		if err := i.Before(req); err != nil {
+			for _, i := range c.interceptors[:n] {
+				i.After(req, nil, err)
+			}
+			return nil, err
+		}
+	}
+
+	resp, err := client.Do(req)
+
+	for _, i := range c.interceptors {
+		i.After(req, resp, err)
+	}
+	return resp, err
+}
+
+// LoggingInterceptor is an Interceptor that logs a summary of each request
+// and its response e.g.
+//
+//	GET https://musicbrainz.org/ws/2/artist?query=Gopher: 200 OK (183ms)
+type LoggingInterceptor struct {
+	logger *log.Logger
+
+	mu    sync.Mutex
+	start map[*http.Request]time.Time
+}
+
+// NewLoggingInterceptor returns a LoggingInterceptor that writes to logger or
+// to the standard logger if logger is nil.
+func NewLoggingInterceptor(logger *log.Logger) *LoggingInterceptor {
+	return &LoggingInterceptor{
+		logger: logger,
+		start:  make(map[*http.Request]time.Time),
+	}
+}
+
+func (l *LoggingInterceptor) Before(req *http.Request) error {
+	l.mu.Lock()
+	l.start[req] = time.Now()
+	l.mu.Unlock()
+	return nil
+}
+
+func (l *LoggingInterceptor) After(req *http.Request, resp *http.Response, err error) {
+	l.mu.Lock()
+	elapsed := time.Since(l.start[req]).Round(time.Millisecond)
+	delete(l.start, req)
+	l.mu.Unlock()
+
+	result := "error: "
+	if err != nil {
+		result += err.Error()
+	} else {
+		result = resp.Status
+	}
+
+	l.printf("%s %s: %s (%v)", req.Method, req.URL, result, elapsed)
+}
+
+func (l *LoggingInterceptor) printf(format string, v ...interface{}) {
+	if l.logger != nil {
+		l.logger.Printf(format, v...)
+		return
+	}
+	log.Printf(format, v...)
+}
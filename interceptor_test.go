@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"bytes"
+	"errors"
+	"log"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+type recordingInterceptor struct {
+	before, after int
+	status        int
+	err           error
+}
+
+func (r *recordingInterceptor) Before(req *http.Request) error {
+	r.before++
+	req.Header.Set("X-Test", "intercepted")
+	return r.err
+}
+
+func (r *recordingInterceptor) After(req *http.Request, resp *http.Response, err error) {
+	r.after++
+	if resp != nil {
+		r.status = resp.StatusCode
+	}
+}
+
+func TestInterceptor(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Test") != "intercepted" {
+			t.Error("request was not modified by the interceptor")
+		}
+		http.ServeFile(w, r, "./testdata/SearchArtist.xml")
+	})
+
+	var buf bytes.Buffer
+	rec := &recordingInterceptor{}
+	client.AddInterceptor(rec)
+	client.AddInterceptor(NewLoggingInterceptor(log.New(&buf, "", 0)))
+
+	if _, err := client.SearchArtist("Gopher"); err != nil {
+		t.Fatal(err)
+	}
+
+	if rec.before != 1 || rec.after != 1 || rec.status != http.StatusOK {
+		t.Errorf("unexpected interceptor calls %+v", rec)
+	}
+	if !strings.HasPrefix(buf.String(), "GET "+server.URL+"/artist?") || !strings.Contains(buf.String(), ": 200 OK (") {
+		t.Errorf("unexpected log output %q", buf.String())
+	}
+}
+
+func TestInterceptorAbort(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should have been aborted")
+	})
+
+	abort := errors.New("aborted")
+	rec := &recordingInterceptor{err: abort}
+	client.AddInterceptor(rec)
+
+	if _, err := client.SearchArtist("Gopher"); err != abort {
+		t.Errorf("expected %v, got %v", abort, err)
+	}
+	if rec.after != 0 {
+		t.Error("After was called for an aborted request")
+	}
+}
+
+func TestInterceptorAbortCallsPreviousAfter(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		t.Error("request should have been aborted")
+	})
+
+	var buf bytes.Buffer
+	logger := NewLoggingInterceptor(log.New(&buf, "", 0))
+	abort := errors.New("aborted")
+	rec := &recordingInterceptor{err: abort}
+	client.AddInterceptor(logger)
+	client.AddInterceptor(rec)
+
+	if _, err := client.SearchArtist("Gopher"); err != abort {
+		t.Errorf("expected %v, got %v", abort, err)
+	}
+	if rec.after != 0 {
+		t.Error("After was called for the aborting interceptor")
+	}
+	if len(logger.start) != 0 {
+		t.Errorf("expected no pending requests, got %d", len(logger.start))
+	}
+	if !strings.Contains(buf.String(), ": error: aborted (") {
+		t.Errorf("unexpected log output %q", buf.String())
+	}
+}
@@ -25,28 +25,39 @@
 
 package gomusicbrainz
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 // LabelInfo contains a label and links it to a catalog number.
 type LabelInfo struct {
-	CatalogNumber string `xml:"catalog-number"`
-	Label         *Label `xml:"label"`
+	CatalogNumber string `xml:"catalog-number" json:"catalogNumber,omitempty"`
+	Label         *Label `xml:"label" json:"label,omitempty"`
 }
 
 // Label represents an imprint, a record company or a music group. Labels refer
 // mainly to imprints in MusicBrainz. Visit https://musicbrainz.org/doc/Label
 // for more information.
 type Label struct {
-	ID             MBID     `xml:"id,attr"`
-	Name           string   `xml:"name"`
-	Type           string   `xml:"type,attr"`
-	SortName       string   `xml:"sort-name"`
-	Disambiguation string   `xml:"disambiguation"`
-	CountryCode    string   `xml:"country"`
-	Area           Area     `xml:"area"`
-	LabelCode      int      `xml:"label-code"`
-	Lifespan       Lifespan `xml:"life-span"`
-	Aliases        []*Alias `xml:"alias-list>alias"`
+	ID             MBID               `xml:"id,attr" json:"id,omitempty"`
+	Name           string             `xml:"name" json:"name,omitempty"`
+	Type           string             `xml:"type,attr" json:"type,omitempty"`
+	SortName       string             `xml:"sort-name" json:"sortName,omitempty"`
+	Disambiguation string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	CountryCode    string             `xml:"country" json:"countryCode,omitempty"`
+	Area           Area               `xml:"area" json:"area,omitempty"`
+	LabelCode      int                `xml:"label-code" json:"labelCode,omitempty"`
+	IPIs           []string           `xml:"ipi-list>ipi" json:"ipis,omitempty"`
+	Lifespan       Lifespan           `xml:"life-span" json:"lifespan,omitempty"`
+	Aliases        []*Alias           `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Releases       []*Release         `xml:"release-list>release" json:"releases,omitempty"`
+	Tags           []Tag              `xml:"tag-list>tag" json:"tags,omitempty"`
+	Genres         []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Rating         Rating             `xml:"rating" json:"rating,omitempty"`
+	UserRating     UserRating         `xml:"user-rating" json:"userRating,omitempty"`
+	Annotation     string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations      TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
 }
 
 func (mbe *Label) lookupResult() interface{} {
@@ -66,10 +77,23 @@ func (mbe *Label) Id() MBID {
 	return mbe.ID
 }
 
+// String returns the name, type, country and MBID of the label.
+func (mbe *Label) String() string {
+	return entityString(mbe.Name, mbe.ID, mbe.Type, mbe.CountryCode)
+}
+
 // LookupLabel performs a label lookup request for the given MBID.
-func (c *WS2Client) LookupLabel(id MBID, inc ...string) (*Label, error) {
+//
+// Possible inc params are releases, aliases, tags, genres, ratings, annotation
+// and <ENTITY>-rels e.g. area-rels or url-rels.
+func (c *WS2Client) LookupLabel(id MBID, inc ...IncludeOption) (*Label, error) {
+	return c.LookupLabelContext(context.Background(), id, inc...)
+}
+
+// LookupLabelContext is like LookupLabel but aborts the request once ctx is done.
+func (c *WS2Client) LookupLabelContext(ctx context.Context, id MBID, inc ...IncludeOption) (*Label, error) {
 	a := &Label{ID: id}
-	err := c.Lookup(a, inc...)
+	err := c.LookupContext(ctx, a, inc...)
 
 	return a, err
 }
@@ -97,10 +121,15 @@ func (c *WS2Client) LookupLabel(id MBID, inc ...string) (*Label, error) {
 // With no fields specified searchTerm searches the label, sortname and alias
 // fields. For more information visit
 // https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Label
-func (c *WS2Client) SearchLabel(searchTerm string, limit, offset int) (*LabelSearchResponse, error) {
+func (c *WS2Client) SearchLabel(searchTerm string, opts ...SearchOption) (*LabelSearchResponse, error) {
+	return c.SearchLabelContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchLabelContext is like SearchLabel but aborts the request once ctx is done.
+func (c *WS2Client) SearchLabelContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*LabelSearchResponse, error) {
 
 	result := labelListResult{}
-	err := c.searchRequest("/label", &result, searchTerm, limit, offset)
+	err := c.searchRequest(ctx, "/label", &result, searchTerm, opts)
 
 	rsp := LabelSearchResponse{}
 	rsp.WS2ListResponse = result.LabelList.WS2ListResponse
@@ -114,11 +143,49 @@ func (c *WS2Client) SearchLabel(searchTerm string, limit, offset int) (*LabelSea
 	return &rsp, err
 }
 
-// LabelSearchResponse is the response type returned by the SearchLabel method.
+// BrowseLabels performs a browse request for Labels linked to the entity
+// with the given MBID. Possible linked entities are area and release e.g.
+//
+//	client.BrowseLabels("release", "07832b54-8266-47d5-bb0e-62c7f2cf5da5", -1, -1)
+//
+// Possible inc params are the same as for LookupLabel. Scores of the
+// returned response are not populated since browse requests are not ranked.
+func (c *WS2Client) BrowseLabels(entity string, id MBID, limit, offset int, inc ...IncludeOption) (*LabelSearchResponse, error) {
+	return c.BrowseLabelsContext(context.Background(), entity, id, limit, offset, inc...)
+}
+
+// BrowseLabelsContext is like BrowseLabels but aborts the request once ctx is done.
+func (c *WS2Client) BrowseLabelsContext(ctx context.Context, entity string, id MBID, limit, offset int, inc ...IncludeOption) (*LabelSearchResponse, error) {
+
+	result := labelListResult{}
+	err := c.browseRequest(ctx, "/label", &result, entity, id, nil, limit, offset, inc)
+
+	rsp := LabelSearchResponse{}
+	rsp.WS2ListResponse = result.LabelList.WS2ListResponse
+
+	for _, v := range result.LabelList.Labels {
+		rsp.Labels = append(rsp.Labels, v.Label)
+	}
+
+	return &rsp, err
+}
+
+// LabelSearchResponse is the response type returned by the SearchLabel and
+// BrowseLabels methods.
 type LabelSearchResponse struct {
 	WS2ListResponse
-	Labels []*Label
-	Scores ScoreMap
+	Labels []*Label `json:"labels,omitempty"`
+	Scores ScoreMap `json:"-"`
+}
+
+// HasMore reports whether there are labels after the ones in r.
+func (r *LabelSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Labels))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *LabelSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Labels))
 }
 
 // ResultsWithScore returns a slice of Labels with a min score.
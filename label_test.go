@@ -26,9 +26,9 @@
 package gomusicbrainz
 
 import (
+	"net/url"
 	"reflect"
 	"testing"
-	"time"
 )
 
 func TestSearchLabel(t *testing.T) {
@@ -53,10 +53,7 @@ func TestSearchLabel(t *testing.T) {
 					SortName: "Germany",
 				},
 				Lifespan: Lifespan{
-					Begin: BrainzTime{
-						Time:     time.Date(1994, 1, 1, 0, 0, 0, 0, time.UTC),
-						Accuracy: Year,
-					},
+					Begin: mustPartialDate("1994"),
 					Ended: false,
 				},
 				Aliases: []*Alias{
@@ -75,7 +72,7 @@ func TestSearchLabel(t *testing.T) {
 	defer server.Close()
 	serveTestFile("/label", "SearchLabel.xml", t)
 
-	returned, err := client.SearchLabel(`label:"Compost%20Records"`, -1, -1)
+	returned, err := client.SearchLabel(`label:"Compost%20Records"`)
 	if err != nil {
 		t.Error(err)
 	}
@@ -88,3 +85,93 @@ func TestSearchLabel(t *testing.T) {
 		t.Error(requestDiff(&want, returned))
 	}
 }
+
+func TestLookupLabel(t *testing.T) {
+
+	want := Label{
+		ID:          "c1c625b5-9929-4a30-8c3e-f77e109cdf07",
+		Type:        "Original Production",
+		Name:        "Compost Records",
+		SortName:    "Compost Records",
+		CountryCode: "DE",
+		LabelCode:   2518,
+		IPIs:        []string{"00180232931"},
+		Area: Area{
+			ID:       "85752fda-13c4-31a3-bee5-0e5cb1f51dad",
+			Name:     "Germany",
+			SortName: "Germany",
+		},
+		Lifespan: Lifespan{
+			Begin: mustPartialDate("1994"),
+		},
+		Releases: []*Release{
+			{
+				ID:    "d10d6b30-ec98-47b7-b1dd-1fda2e2bd4c2",
+				Title: "Future Sound of Jazz",
+			},
+		},
+		Tags: []Tag{
+			{
+				Count: 1,
+				Name:  "nu jazz",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile(
+		"/label/c1c625b5-9929-4a30-8c3e-f77e109cdf07",
+		"LookupLabel.xml", t)
+
+	returned, err := client.LookupLabel(
+		"c1c625b5-9929-4a30-8c3e-f77e109cdf07",
+		"releases",
+		"tags")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestBrowseLabels(t *testing.T) {
+
+	want := LabelSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		Labels: []*Label{
+			{
+				ID:        "2ec8fd58-cffb-4bed-bf50-c8d5a6b0daf2",
+				Type:      "Original Production",
+				Name:      "Virgin",
+				SortName:  "Virgin",
+				LabelCode: 3098,
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/label", "BrowseLabels.xml", url.Values{
+		"release": {"07832b54-8266-47d5-bb0e-62c7f2cf5da5"},
+	}, t)
+
+	returned, err := client.BrowseLabels(
+		"release",
+		"07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+		-1, -1)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
@@ -0,0 +1,185 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxLatencySamples is the number of most recent latencies per endpoint the
+// latency percentiles are computed from.
+const maxLatencySamples = 1024
+
+// ClientMetrics is a snapshot of the request metrics of a WS2Client keyed by
+// endpoint e.g. "/artist". Lookups are counted for the endpoint of their
+// entity type.
+type ClientMetrics struct {
+	Endpoints map[string]EndpointMetrics
+}
+
+// EndpointMetrics holds the metrics of the requests to a single endpoint.
+// Every attempt of a request is counted including retries. A request fails if
+// it could not be sent or was answered with an HTTP status >= 400.
+type EndpointMetrics struct {
+	Requests int64
+	Failures int64
+	// Latency percentiles of the most recent requests.
+	P50, P90, P99 time.Duration
+}
+
+// SuccessRate returns the fraction of successful requests or 0 if there were
+// no requests.
+func (m EndpointMetrics) SuccessRate() float64 {
+	if m.Requests == 0 {
+		return 0
+	}
+	return float64(m.Requests-m.Failures) / float64(m.Requests)
+}
+
+// EnableMetrics starts collecting request metrics which can be retrieved with
+// Metrics. It must not be called concurrently with requests.
+func (c *WS2Client) EnableMetrics() {
+	if c.metrics == nil {
+		c.metrics = newMetricsCollector()
+		c.AddInterceptor(c.metrics)
+	}
+}
+
+// Metrics returns a snapshot of the request metrics collected since
+// EnableMetrics was called. The snapshot is empty if metrics are disabled.
+func (c *WS2Client) Metrics() ClientMetrics {
+	if c.metrics == nil {
+		return ClientMetrics{Endpoints: map[string]EndpointMetrics{}}
+	}
+	return c.metrics.snapshot()
+}
+
+// endpointStats collects the metrics of a single endpoint.
+type endpointStats struct {
+	requests  int64
+	failures  int64
+	latencies []time.Duration // ring buffer of the most recent latencies
+	next      int
+}
+
+// metricsCollector is an Interceptor that collects request metrics.
+type metricsCollector struct {
+	mu        sync.Mutex
+	endpoints map[string]*endpointStats
+	start     map[*http.Request]time.Time
+}
+
+func newMetricsCollector() *metricsCollector {
+	return &metricsCollector{
+		endpoints: make(map[string]*endpointStats),
+		start:     make(map[*http.Request]time.Time),
+	}
+}
+
+func (m *metricsCollector) Before(req *http.Request) error {
+	m.mu.Lock()
+	m.start[req] = time.Now()
+	m.mu.Unlock()
+	return nil
+}
+
+func (m *metricsCollector) After(req *http.Request, resp *http.Response, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	latency := time.Since(m.start[req])
+	delete(m.start, req)
+
+	endpoint := endpointOf(req.URL.Path)
+	s, ok := m.endpoints[endpoint]
+	if !ok {
+		s = &endpointStats{}
+		m.endpoints[endpoint] = s
+	}
+
+	s.requests++
+	if err != nil || resp.StatusCode >= 400 {
+		s.failures++
+	}
+
+	if len(s.latencies) < maxLatencySamples {
+		s.latencies = append(s.latencies, latency)
+	} else {
+		s.latencies[s.next] = latency
+		s.next = (s.next + 1) % maxLatencySamples
+	}
+}
+
+func (m *metricsCollector) snapshot() ClientMetrics {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cm := ClientMetrics{Endpoints: make(map[string]EndpointMetrics, len(m.endpoints))}
+
+	for endpoint, s := range m.endpoints {
+		sorted := append([]time.Duration(nil), s.latencies...)
+		sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+		cm.Endpoints[endpoint] = EndpointMetrics{
+			Requests: s.requests,
+			Failures: s.failures,
+			P50:      percentile(sorted, 50),
+			P90:      percentile(sorted, 90),
+			P99:      percentile(sorted, 99),
+		}
+	}
+	return cm
+}
+
+// percentile returns the p-th percentile of sorted using the nearest-rank
+// method.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// endpointOf returns the endpoint of a WS2 request path e.g. "/artist" for
+// "/ws/2/artist/10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8".
+func endpointOf(p string) string {
+	if i := strings.Index(p, "/ws/2/"); i >= 0 {
+		p = p[i+len("/ws/2"):]
+	}
+	p = strings.TrimPrefix(p, "/")
+	if i := strings.Index(p, "/"); i >= 0 {
+		p = p[:i]
+	}
+	return "/" + p
+}
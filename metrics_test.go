@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMetrics(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/artist", "SearchArtist.xml", t)
+
+	if m := client.Metrics(); len(m.Endpoints) != 0 {
+		t.Errorf("expected no metrics while disabled, got %+v", m)
+	}
+
+	client.EnableMetrics()
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.SearchArtist("Gopher"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := client.LookupRelease("0b2ac8c4-cb18-4d28-a2a2-9c6ac5ae1a5d"); err == nil {
+		t.Error("expected an error for an unknown endpoint")
+	}
+
+	m := client.Metrics()
+
+	artist := m.Endpoints["/artist"]
+	if artist.Requests != 2 || artist.Failures != 0 || artist.SuccessRate() != 1 {
+		t.Errorf("unexpected /artist metrics %+v", artist)
+	}
+	if artist.P50 <= 0 || artist.P50 > artist.P90 || artist.P90 > artist.P99 {
+		t.Errorf("unexpected /artist latencies %+v", artist)
+	}
+
+	release := m.Endpoints["/release"]
+	if release.Requests != 1 || release.Failures != 1 || release.SuccessRate() != 0 {
+		t.Errorf("unexpected /release metrics %+v", release)
+	}
+
+	if m := client.Clone().Metrics(); len(m.Endpoints) != 0 {
+		t.Errorf("expected a clone to start with empty metrics, got %+v", m)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 10; i++ {
+		sorted = append(sorted, time.Duration(i)*time.Millisecond)
+	}
+
+	for p, want := range map[int]time.Duration{
+		50: 5 * time.Millisecond,
+		90: 9 * time.Millisecond,
+		99: 10 * time.Millisecond,
+	} {
+		if got := percentile(sorted, p); got != want {
+			t.Errorf("percentile %d: want %v, got %v", p, want, got)
+		}
+	}
+
+	if got := percentile(nil, 50); got != 0 {
+		t.Errorf("expected 0 for no samples, got %v", got)
+	}
+}
+
+func TestEndpointOf(t *testing.T) {
+	for p, want := range map[string]string{
+		"/ws/2/artist":          "/artist",
+		"/ws/2/artist/some-id":  "/artist",
+		"/release-group/id":     "/release-group",
+		"/discid/some-disc-id/": "/discid",
+	} {
+		if got := endpointOf(p); got != want {
+			t.Errorf("%s: want %q, got %q", p, want, got)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"sync"
+)
+
+// MultiEntitySearchResponse is the response type returned by SearchAll. A
+// response is nil if the search for the respective entity type failed, see
+// Errors.
+type MultiEntitySearchResponse struct {
+	Artists       *ArtistSearchResponse
+	Releases      *ReleaseSearchResponse
+	Recordings    *RecordingSearchResponse
+	Works         *WorkSearchResponse
+	ReleaseGroups *ReleaseGroupSearchResponse
+
+	// Errors maps entity types e.g. "artist" to the error of the failed
+	// search for this type.
+	Errors map[string]error
+}
+
+// SearchAll searches artists, releases, recordings, works and release groups
+// for term concurrently and returns the first limit results of each. Errors
+// of the single searches are collected in the Errors field of the response.
+// An error is only returned if all searches failed. Note that the rate limit
+// still applies, so the searches take at least as long as the rate limit
+// requires.
+func (c *WS2Client) SearchAll(ctx context.Context, term string, limit int) (*MultiEntitySearchResponse, error) {
+
+	rsp := MultiEntitySearchResponse{Errors: make(map[string]error)}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+
+	search := func(entity string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				mu.Lock()
+				rsp.Errors[entity] = err
+				mu.Unlock()
+			}
+		}()
+	}
+
+	// every goroutine writes to its own field of rsp only
+	search("artist", func() error {
+		a, err := c.SearchArtistContext(ctx, term, WithLimit(limit))
+		if err == nil {
+			rsp.Artists = a
+		}
+		return err
+	})
+	search("release", func() error {
+		r, err := c.SearchReleaseContext(ctx, term, WithLimit(limit))
+		if err == nil {
+			rsp.Releases = r
+		}
+		return err
+	})
+	search("recording", func() error {
+		r, err := c.SearchRecordingContext(ctx, term, WithLimit(limit))
+		if err == nil {
+			rsp.Recordings = r
+		}
+		return err
+	})
+	search("work", func() error {
+		w, err := c.SearchWorkContext(ctx, term, WithLimit(limit))
+		if err == nil {
+			rsp.Works = w
+		}
+		return err
+	})
+	search("release-group", func() error {
+		r, err := c.SearchReleaseGroupContext(ctx, term, WithLimit(limit))
+		if err == nil {
+			rsp.ReleaseGroups = r
+		}
+		return err
+	})
+
+	wg.Wait()
+
+	if len(rsp.Errors) == 5 {
+		return &rsp, rsp.Errors["artist"]
+	}
+	return &rsp, nil
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestSearchAll(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/artist", "SearchArtist.xml", t)
+	serveTestFile("/release", "SearchRelease.xml", t)
+	serveTestFile("/recording", "SearchRecording.xml", t)
+	serveTestFile("/work", "SearchWork.xml", t)
+	mux.HandleFunc("/release-group", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	rsp, err := client.SearchAll(context.Background(), "Protection", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rsp.Artists == nil || rsp.Releases == nil || rsp.Recordings == nil || rsp.Works == nil {
+		t.Errorf("expected responses for all successful searches, got %+v", rsp)
+	}
+	if rsp.ReleaseGroups != nil {
+		t.Error("expected no release group response")
+	}
+	if len(rsp.Errors) != 1 || rsp.Errors["release-group"] == nil {
+		t.Errorf("expected only a release-group error, got %v", rsp.Errors)
+	}
+}
+
+func TestSearchAllCanceled(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	rsp, err := client.SearchAll(ctx, "Protection", 10)
+	if err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if len(rsp.Errors) != 5 {
+		t.Errorf("expected 5 errors, got %v", rsp.Errors)
+	}
+}
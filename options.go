@@ -0,0 +1,127 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures a WS2Client created by NewWS2Client.
+type Option func(*WS2Client) error
+
+// WithRootURL sets the WS2 root URL e.g. to use a MusicBrainz mirror. The path
+// ws/2 is appended if missing. Defaults to DefaultRootURL.
+func WithRootURL(wsurl string) Option {
+	return func(c *WS2Client) error {
+		return setRootURL(c, wsurl)
+	}
+}
+
+// WithAppInfo sets the name, version and contact (URL or e-mail) of your
+// application which are sent as User-Agent with every request.
+func WithAppInfo(appname, version, contact string) Option {
+	return func(c *WS2Client) error {
+		c.SetAppInfo(appname, version, contact)
+		return nil
+	}
+}
+
+// WithTimeout sets the time limit for each request, see SetTimeout. Defaults
+// to DefaultTimeout.
+func WithTimeout(d time.Duration) Option {
+	return func(c *WS2Client) error {
+		c.SetTimeout(d)
+		return nil
+	}
+}
+
+// WithMaxRetries sets how often a request is retried if the rate limit is
+// exceeded, see SetMaxRetries. Defaults to DefaultMaxRetries.
+func WithMaxRetries(n int) Option {
+	return func(c *WS2Client) error {
+		c.SetMaxRetries(n)
+		return nil
+	}
+}
+
+// WithHTTPClient sets the http.Client used to perform requests, see
+// SetHTTPClient.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *WS2Client) error {
+		c.SetHTTPClient(client)
+		return nil
+	}
+}
+
+// WithRateLimit sets the maximum number of requests per second, see
+// SetRateLimit. Defaults to DefaultRequestsPerSecond.
+func WithRateLimit(requestsPerSecond float64) Option {
+	return func(c *WS2Client) error {
+		c.SetRateLimit(requestsPerSecond)
+		return nil
+	}
+}
+
+// WithInterceptor adds an Interceptor to the client, see AddInterceptor.
+func WithInterceptor(i Interceptor) Option {
+	return func(c *WS2Client) error {
+		c.AddInterceptor(i)
+		return nil
+	}
+}
+
+// WithMetrics enables the collection of request metrics, see EnableMetrics.
+func WithMetrics() Option {
+	return func(c *WS2Client) error {
+		c.EnableMetrics()
+		return nil
+	}
+}
+
+// WithCache enables an in-memory cache for up to maxEntries responses which are
+// kept for ttl. A ttl <= 0 keeps responses until they are evicted. Cached
+// responses are returned without performing a request and thus do not count
+// against the rate limit. Responses with an ETag or Last-Modified header are
+// kept beyond ttl and revalidated with a conditional request once stale.
+func WithCache(maxEntries int, ttl time.Duration) Option {
+	return func(c *WS2Client) error {
+		c.cache = NewLRUCache(maxEntries)
+		c.cacheTTL = ttl
+		return nil
+	}
+}
+
+// WithCacheBackend enables caching of responses in cache. Entries are set
+// without ttl so expiry is up to the backend. Use WithCache for the built-in
+// in-memory cache.
+func WithCacheBackend(cache Cache) Option {
+	return func(c *WS2Client) error {
+		c.cache = cache
+		c.cacheTTL = 0
+		return nil
+	}
+}
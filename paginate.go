@@ -0,0 +1,1035 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"errors"
+)
+
+// MaxFetchAllResults is the maximum number of results fetched by the
+// Search<ENTITY>All methods.
+const MaxFetchAllResults = 10000
+
+// maxPageSize is the maximum limit of a search request supported by WS2.
+const maxPageSize = 100
+
+// ErrTooManyResults is returned by the Search<ENTITY>All methods along with
+// the first MaxFetchAllResults results if a search matches more results.
+var ErrTooManyResults = errors.New("search matches more than MaxFetchAllResults results.")
+
+// Paginator implements the iteration shared by the <ENTITY>Paginator types
+// returned by the Search<ENTITY>Pages methods. Each of them adds a Results
+// method returning the results of the current page:
+//
+//	p := client.SearchArtistPages("Gopher", 100)
+//	for p.Next() {
+//		for _, artist := range p.Results() {
+//			...
+//		}
+//	}
+//	if err := p.Err(); err != nil {
+//		...
+//	}
+//
+// The Search<ENTITY>All methods use a Paginator to fetch all results of a
+// search into a single response with merged Scores. They stop with
+// ErrTooManyResults after MaxFetchAllResults results.
+//
+// The Search<ENTITY>Stream methods use a Paginator in the background and
+// send each result on the returned results channel. It is closed once all
+// pages are fetched, ctx is done or an error occurred. The error, if any, is
+// sent on the error channel before that is closed too.
+type Paginator struct {
+	ctx    context.Context
+	limit  int
+	offset int
+	count  int // total number of results, -1 until the first page is fetched
+	page   searchPage
+	err    error
+	fetch  func(ctx context.Context, limit, offset int) (searchPage, error)
+}
+
+// searchPage describes a page fetched by a Paginator.
+type searchPage struct {
+	list   WS2ListResponse
+	scores ScoreMap
+	len    int // number of results on the page
+}
+
+func newPaginator(ctx context.Context, limit int, fetch func(ctx context.Context, limit, offset int) (searchPage, error)) Paginator {
+	return Paginator{ctx: ctx, limit: limit, count: -1, fetch: fetch}
+}
+
+// Next fetches the next page. It returns false if there are no more pages or
+// an error occurred, see Err.
+func (p *Paginator) Next() bool {
+	if p.fetch == nil || p.err != nil || !p.more() {
+		return false
+	}
+
+	page, err := p.fetch(p.ctx, p.limit, p.offset)
+	if err != nil {
+		p.err = err
+		return false
+	}
+
+	p.page = page
+	p.count = page.list.Count
+	p.offset += page.len
+
+	// guard against endless paging if WS2 returns less results than counted
+	if page.len == 0 {
+		p.count = p.offset
+		return false
+	}
+	return true
+}
+
+// Scores returns the scores of the results of the current page.
+func (p *Paginator) Scores() ScoreMap {
+	return p.page.scores
+}
+
+// Err returns the error that stopped the iteration, if any.
+func (p *Paginator) Err() error {
+	return p.err
+}
+
+// more reports whether there are results left to fetch.
+func (p *Paginator) more() bool {
+	return p.count < 0 || p.offset < p.count
+}
+
+// all fetches the remaining pages and calls add for each of them to collect
+// its results. The total count and the scores are merged into list and scores.
+func (p *Paginator) all(list *WS2ListResponse, scores *ScoreMap, add func()) error {
+	*scores = make(ScoreMap)
+
+	n := 0
+	for p.Next() {
+		list.Count = p.count
+		add()
+		for k, v := range p.page.scores {
+			(*scores)[k] = v
+		}
+
+		n += p.page.len
+		if n >= MaxFetchAllResults && p.more() {
+			return ErrTooManyResults
+		}
+	}
+	return p.Err()
+}
+
+// stream fetches the remaining pages in the background and calls send with
+// the index of each result on the current page. send returns false once the
+// context of p is done. done is called when the stream ends. The returned
+// channel receives the error that stopped the stream, if any.
+func (p *Paginator) stream(send func(i int) bool, done func()) <-chan error {
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(errc)
+		defer done()
+
+		for p.Next() {
+			for i := 0; i < p.page.len; i++ {
+				if !send(i) {
+					errc <- p.ctx.Err()
+					return
+				}
+			}
+		}
+		if err := p.Err(); err != nil {
+			errc <- err
+		}
+	}()
+
+	return errc
+}
+
+// AnnotationPaginator pages through the annotations whose text matches a
+// search. The results mix annotations of all entity types.
+type AnnotationPaginator struct {
+	Paginator
+	results []*Annotation
+}
+
+// Results returns the annotations on the current page.
+func (p *AnnotationPaginator) Results() []*Annotation {
+	return p.results
+}
+
+// SearchAnnotationPages returns an AnnotationPaginator over the annotations
+// matching searchTerm with limit (1-100) annotations per page.
+func (c *WS2Client) SearchAnnotationPages(searchTerm string, limit int) *AnnotationPaginator {
+	return c.SearchAnnotationPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchAnnotationPagesContext is like SearchAnnotationPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchAnnotationPagesContext(ctx context.Context, searchTerm string, limit int) *AnnotationPaginator {
+	p := &AnnotationPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchAnnotationContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Annotations
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Annotations)}, nil
+	})
+	return p
+}
+
+// SearchAnnotationAll collects every annotation matching searchTerm in a single
+// response. See Paginator.
+func (c *WS2Client) SearchAnnotationAll(searchTerm string) (*AnnotationSearchResponse, error) {
+	return c.SearchAnnotationAllContext(context.Background(), searchTerm)
+}
+
+// SearchAnnotationAllContext is like SearchAnnotationAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchAnnotationAllContext(ctx context.Context, searchTerm string) (*AnnotationSearchResponse, error) {
+	rsp := &AnnotationSearchResponse{}
+	p := c.SearchAnnotationPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Annotations = append(rsp.Annotations, p.results...) })
+	return rsp, err
+}
+
+// SearchAnnotationStream sends the annotations matching searchTerm on the
+// returned channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchAnnotationStream(ctx context.Context, searchTerm string, limit int) (<-chan *Annotation, <-chan error) {
+	results := make(chan *Annotation)
+	p := c.SearchAnnotationPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// AreaPaginator pages through the results of an area search e.g. every city
+// named Springfield.
+type AreaPaginator struct {
+	Paginator
+	results []*Area
+}
+
+// Results returns the areas on the current page.
+func (p *AreaPaginator) Results() []*Area {
+	return p.results
+}
+
+// SearchAreaPages returns an AreaPaginator over the areas matching searchTerm
+// with limit (1-100) areas per page.
+func (c *WS2Client) SearchAreaPages(searchTerm string, limit int) *AreaPaginator {
+	return c.SearchAreaPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchAreaPagesContext is like SearchAreaPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchAreaPagesContext(ctx context.Context, searchTerm string, limit int) *AreaPaginator {
+	p := &AreaPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchAreaContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Areas
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Areas)}, nil
+	})
+	return p
+}
+
+// SearchAreaAll collects every area matching searchTerm in a single response.
+// See Paginator.
+func (c *WS2Client) SearchAreaAll(searchTerm string) (*AreaSearchResponse, error) {
+	return c.SearchAreaAllContext(context.Background(), searchTerm)
+}
+
+// SearchAreaAllContext is like SearchAreaAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchAreaAllContext(ctx context.Context, searchTerm string) (*AreaSearchResponse, error) {
+	rsp := &AreaSearchResponse{}
+	p := c.SearchAreaPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Areas = append(rsp.Areas, p.results...) })
+	return rsp, err
+}
+
+// SearchAreaStream sends the areas matching searchTerm on the returned channel
+// as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchAreaStream(ctx context.Context, searchTerm string, limit int) (<-chan *Area, <-chan error) {
+	results := make(chan *Area)
+	p := c.SearchAreaPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// ArtistPaginator pages through the results of an artist search.
+type ArtistPaginator struct {
+	Paginator
+	results []*Artist
+}
+
+// Results returns the artists on the current page.
+func (p *ArtistPaginator) Results() []*Artist {
+	return p.results
+}
+
+// SearchArtistPages returns an ArtistPaginator over the artists matching
+// searchTerm with limit (1-100) artists per page.
+func (c *WS2Client) SearchArtistPages(searchTerm string, limit int) *ArtistPaginator {
+	return c.SearchArtistPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchArtistPagesContext is like SearchArtistPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchArtistPagesContext(ctx context.Context, searchTerm string, limit int) *ArtistPaginator {
+	p := &ArtistPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchArtistContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Artists
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Artists)}, nil
+	})
+	return p
+}
+
+// SearchArtistAll collects every artist matching searchTerm in a single
+// response. See Paginator.
+func (c *WS2Client) SearchArtistAll(searchTerm string) (*ArtistSearchResponse, error) {
+	return c.SearchArtistAllContext(context.Background(), searchTerm)
+}
+
+// SearchArtistAllContext is like SearchArtistAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchArtistAllContext(ctx context.Context, searchTerm string) (*ArtistSearchResponse, error) {
+	rsp := &ArtistSearchResponse{}
+	p := c.SearchArtistPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Artists = append(rsp.Artists, p.results...) })
+	return rsp, err
+}
+
+// SearchArtistStream sends the artists matching searchTerm on the returned
+// channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchArtistStream(ctx context.Context, searchTerm string, limit int) (<-chan *Artist, <-chan error) {
+	results := make(chan *Artist)
+	p := c.SearchArtistPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// CDStubPaginator pages through CD stubs, the unreviewed disc listings
+// submitted by users, that match a search.
+type CDStubPaginator struct {
+	Paginator
+	results []*CDStub
+}
+
+// Results returns the CD stubs on the current page.
+func (p *CDStubPaginator) Results() []*CDStub {
+	return p.results
+}
+
+// SearchCDStubPages returns a CDStubPaginator over the CD stubs matching
+// searchTerm with limit (1-100) stubs per page.
+func (c *WS2Client) SearchCDStubPages(searchTerm string, limit int) *CDStubPaginator {
+	return c.SearchCDStubPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchCDStubPagesContext is like SearchCDStubPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchCDStubPagesContext(ctx context.Context, searchTerm string, limit int) *CDStubPaginator {
+	p := &CDStubPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchCDStubContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.CDStubs
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.CDStubs)}, nil
+	})
+	return p
+}
+
+// SearchCDStubAll collects every CD stub matching searchTerm in a single
+// response. See Paginator.
+func (c *WS2Client) SearchCDStubAll(searchTerm string) (*CDStubSearchResponse, error) {
+	return c.SearchCDStubAllContext(context.Background(), searchTerm)
+}
+
+// SearchCDStubAllContext is like SearchCDStubAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchCDStubAllContext(ctx context.Context, searchTerm string) (*CDStubSearchResponse, error) {
+	rsp := &CDStubSearchResponse{}
+	p := c.SearchCDStubPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.CDStubs = append(rsp.CDStubs, p.results...) })
+	return rsp, err
+}
+
+// SearchCDStubStream sends the CD stubs matching searchTerm on the returned
+// channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchCDStubStream(ctx context.Context, searchTerm string, limit int) (<-chan *CDStub, <-chan error) {
+	results := make(chan *CDStub)
+	p := c.SearchCDStubPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// EventPaginator pages through the results of an event search such as every
+// date of a tour.
+type EventPaginator struct {
+	Paginator
+	results []*Event
+}
+
+// Results returns the events on the current page.
+func (p *EventPaginator) Results() []*Event {
+	return p.results
+}
+
+// SearchEventPages returns an EventPaginator over the events matching
+// searchTerm with limit (1-100) events per page.
+func (c *WS2Client) SearchEventPages(searchTerm string, limit int) *EventPaginator {
+	return c.SearchEventPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchEventPagesContext is like SearchEventPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchEventPagesContext(ctx context.Context, searchTerm string, limit int) *EventPaginator {
+	p := &EventPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchEventContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Events
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Events)}, nil
+	})
+	return p
+}
+
+// SearchEventAll collects every event matching searchTerm in a single response.
+// See Paginator.
+func (c *WS2Client) SearchEventAll(searchTerm string) (*EventSearchResponse, error) {
+	return c.SearchEventAllContext(context.Background(), searchTerm)
+}
+
+// SearchEventAllContext is like SearchEventAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchEventAllContext(ctx context.Context, searchTerm string) (*EventSearchResponse, error) {
+	rsp := &EventSearchResponse{}
+	p := c.SearchEventPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Events = append(rsp.Events, p.results...) })
+	return rsp, err
+}
+
+// SearchEventStream sends the events matching searchTerm on the returned
+// channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchEventStream(ctx context.Context, searchTerm string, limit int) (<-chan *Event, <-chan error) {
+	results := make(chan *Event)
+	p := c.SearchEventPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// InstrumentPaginator pages through the results of an instrument search.
+type InstrumentPaginator struct {
+	Paginator
+	results []*Instrument
+}
+
+// Results returns the instruments on the current page.
+func (p *InstrumentPaginator) Results() []*Instrument {
+	return p.results
+}
+
+// SearchInstrumentPages returns an InstrumentPaginator over the instruments
+// matching searchTerm with limit (1-100) instruments per page.
+func (c *WS2Client) SearchInstrumentPages(searchTerm string, limit int) *InstrumentPaginator {
+	return c.SearchInstrumentPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchInstrumentPagesContext is like SearchInstrumentPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchInstrumentPagesContext(ctx context.Context, searchTerm string, limit int) *InstrumentPaginator {
+	p := &InstrumentPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchInstrumentContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Instruments
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Instruments)}, nil
+	})
+	return p
+}
+
+// SearchInstrumentAll collects every instrument matching searchTerm in a single
+// response. The instrument tree is small enough for this to take only a few
+// requests. See Paginator.
+func (c *WS2Client) SearchInstrumentAll(searchTerm string) (*InstrumentSearchResponse, error) {
+	return c.SearchInstrumentAllContext(context.Background(), searchTerm)
+}
+
+// SearchInstrumentAllContext is like SearchInstrumentAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchInstrumentAllContext(ctx context.Context, searchTerm string) (*InstrumentSearchResponse, error) {
+	rsp := &InstrumentSearchResponse{}
+	p := c.SearchInstrumentPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Instruments = append(rsp.Instruments, p.results...) })
+	return rsp, err
+}
+
+// SearchInstrumentStream sends the instruments matching searchTerm on the
+// returned channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchInstrumentStream(ctx context.Context, searchTerm string, limit int) (<-chan *Instrument, <-chan error) {
+	results := make(chan *Instrument)
+	p := c.SearchInstrumentPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// LabelPaginator pages through the results of a label search.
+type LabelPaginator struct {
+	Paginator
+	results []*Label
+}
+
+// Results returns the labels on the current page.
+func (p *LabelPaginator) Results() []*Label {
+	return p.results
+}
+
+// SearchLabelPages returns a LabelPaginator over the labels matching searchTerm
+// with limit (1-100) labels per page.
+func (c *WS2Client) SearchLabelPages(searchTerm string, limit int) *LabelPaginator {
+	return c.SearchLabelPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchLabelPagesContext is like SearchLabelPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchLabelPagesContext(ctx context.Context, searchTerm string, limit int) *LabelPaginator {
+	p := &LabelPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchLabelContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Labels
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Labels)}, nil
+	})
+	return p
+}
+
+// SearchLabelAll collects every label matching searchTerm in a single response.
+// See Paginator.
+func (c *WS2Client) SearchLabelAll(searchTerm string) (*LabelSearchResponse, error) {
+	return c.SearchLabelAllContext(context.Background(), searchTerm)
+}
+
+// SearchLabelAllContext is like SearchLabelAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchLabelAllContext(ctx context.Context, searchTerm string) (*LabelSearchResponse, error) {
+	rsp := &LabelSearchResponse{}
+	p := c.SearchLabelPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Labels = append(rsp.Labels, p.results...) })
+	return rsp, err
+}
+
+// SearchLabelStream sends the labels matching searchTerm on the returned
+// channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchLabelStream(ctx context.Context, searchTerm string, limit int) (<-chan *Label, <-chan error) {
+	results := make(chan *Label)
+	p := c.SearchLabelPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// PlacePaginator pages through the venues, studios and other places matching a
+// search.
+type PlacePaginator struct {
+	Paginator
+	results []*Place
+}
+
+// Results returns the places on the current page.
+func (p *PlacePaginator) Results() []*Place {
+	return p.results
+}
+
+// SearchPlacePages returns a PlacePaginator over the places matching searchTerm
+// with limit (1-100) places per page.
+func (c *WS2Client) SearchPlacePages(searchTerm string, limit int) *PlacePaginator {
+	return c.SearchPlacePagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchPlacePagesContext is like SearchPlacePages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchPlacePagesContext(ctx context.Context, searchTerm string, limit int) *PlacePaginator {
+	p := &PlacePaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchPlaceContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Places
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Places)}, nil
+	})
+	return p
+}
+
+// SearchPlaceAll collects every place matching searchTerm in a single response.
+// See Paginator.
+func (c *WS2Client) SearchPlaceAll(searchTerm string) (*PlaceSearchResponse, error) {
+	return c.SearchPlaceAllContext(context.Background(), searchTerm)
+}
+
+// SearchPlaceAllContext is like SearchPlaceAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchPlaceAllContext(ctx context.Context, searchTerm string) (*PlaceSearchResponse, error) {
+	rsp := &PlaceSearchResponse{}
+	p := c.SearchPlacePagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Places = append(rsp.Places, p.results...) })
+	return rsp, err
+}
+
+// SearchPlaceStream sends the places matching searchTerm on the returned
+// channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchPlaceStream(ctx context.Context, searchTerm string, limit int) (<-chan *Place, <-chan error) {
+	results := make(chan *Place)
+	p := c.SearchPlacePagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// RecordingPaginator pages through the results of a recording search. Common
+// titles match thousands of recordings, so narrow the query e.g. by artist
+// where possible.
+type RecordingPaginator struct {
+	Paginator
+	results []*Recording
+}
+
+// Results returns the recordings on the current page.
+func (p *RecordingPaginator) Results() []*Recording {
+	return p.results
+}
+
+// SearchRecordingPages returns a RecordingPaginator over the recordings
+// matching searchTerm with limit (1-100) recordings per page.
+func (c *WS2Client) SearchRecordingPages(searchTerm string, limit int) *RecordingPaginator {
+	return c.SearchRecordingPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchRecordingPagesContext is like SearchRecordingPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchRecordingPagesContext(ctx context.Context, searchTerm string, limit int) *RecordingPaginator {
+	p := &RecordingPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchRecordingContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Recordings
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Recordings)}, nil
+	})
+	return p
+}
+
+// SearchRecordingAll collects every recording matching searchTerm in a single
+// response. Broad queries easily exceed MaxFetchAllResults, see Paginator.
+func (c *WS2Client) SearchRecordingAll(searchTerm string) (*RecordingSearchResponse, error) {
+	return c.SearchRecordingAllContext(context.Background(), searchTerm)
+}
+
+// SearchRecordingAllContext is like SearchRecordingAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchRecordingAllContext(ctx context.Context, searchTerm string) (*RecordingSearchResponse, error) {
+	rsp := &RecordingSearchResponse{}
+	p := c.SearchRecordingPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Recordings = append(rsp.Recordings, p.results...) })
+	return rsp, err
+}
+
+// SearchRecordingStream sends the recordings matching searchTerm on the
+// returned channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchRecordingStream(ctx context.Context, searchTerm string, limit int) (<-chan *Recording, <-chan error) {
+	results := make(chan *Recording)
+	p := c.SearchRecordingPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// ReleasePaginator pages through the results of a release search.
+type ReleasePaginator struct {
+	Paginator
+	results []*Release
+}
+
+// Results returns the releases on the current page.
+func (p *ReleasePaginator) Results() []*Release {
+	return p.results
+}
+
+// SearchReleasePages returns a ReleasePaginator over the releases matching
+// searchTerm with limit (1-100) releases per page.
+func (c *WS2Client) SearchReleasePages(searchTerm string, limit int) *ReleasePaginator {
+	return c.SearchReleasePagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchReleasePagesContext is like SearchReleasePages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchReleasePagesContext(ctx context.Context, searchTerm string, limit int) *ReleasePaginator {
+	p := &ReleasePaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchReleaseContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Releases
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Releases)}, nil
+	})
+	return p
+}
+
+// SearchReleaseAll collects every release matching searchTerm in a single
+// response. See Paginator.
+func (c *WS2Client) SearchReleaseAll(searchTerm string) (*ReleaseSearchResponse, error) {
+	return c.SearchReleaseAllContext(context.Background(), searchTerm)
+}
+
+// SearchReleaseAllContext is like SearchReleaseAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchReleaseAllContext(ctx context.Context, searchTerm string) (*ReleaseSearchResponse, error) {
+	rsp := &ReleaseSearchResponse{}
+	p := c.SearchReleasePagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Releases = append(rsp.Releases, p.results...) })
+	return rsp, err
+}
+
+// SearchReleaseStream sends the releases matching searchTerm on the returned
+// channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchReleaseStream(ctx context.Context, searchTerm string, limit int) (<-chan *Release, <-chan error) {
+	results := make(chan *Release)
+	p := c.SearchReleasePagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// ReleaseGroupPaginator pages through the results of a release group search.
+type ReleaseGroupPaginator struct {
+	Paginator
+	results []*ReleaseGroup
+}
+
+// Results returns the release groups on the current page.
+func (p *ReleaseGroupPaginator) Results() []*ReleaseGroup {
+	return p.results
+}
+
+// SearchReleaseGroupPages returns a ReleaseGroupPaginator over the release
+// groups matching searchTerm with limit (1-100) release groups per page.
+func (c *WS2Client) SearchReleaseGroupPages(searchTerm string, limit int) *ReleaseGroupPaginator {
+	return c.SearchReleaseGroupPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchReleaseGroupPagesContext is like SearchReleaseGroupPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchReleaseGroupPagesContext(ctx context.Context, searchTerm string, limit int) *ReleaseGroupPaginator {
+	p := &ReleaseGroupPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchReleaseGroupContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.ReleaseGroups
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.ReleaseGroups)}, nil
+	})
+	return p
+}
+
+// SearchReleaseGroupAll collects every release group matching searchTerm in a
+// single response. See Paginator.
+func (c *WS2Client) SearchReleaseGroupAll(searchTerm string) (*ReleaseGroupSearchResponse, error) {
+	return c.SearchReleaseGroupAllContext(context.Background(), searchTerm)
+}
+
+// SearchReleaseGroupAllContext is like SearchReleaseGroupAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchReleaseGroupAllContext(ctx context.Context, searchTerm string) (*ReleaseGroupSearchResponse, error) {
+	rsp := &ReleaseGroupSearchResponse{}
+	p := c.SearchReleaseGroupPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.ReleaseGroups = append(rsp.ReleaseGroups, p.results...) })
+	return rsp, err
+}
+
+// SearchReleaseGroupStream sends the release groups matching searchTerm on the
+// returned channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchReleaseGroupStream(ctx context.Context, searchTerm string, limit int) (<-chan *ReleaseGroup, <-chan error) {
+	results := make(chan *ReleaseGroup)
+	p := c.SearchReleaseGroupPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// SeriesPaginator pages through the results of a series search.
+type SeriesPaginator struct {
+	Paginator
+	results []*Series
+}
+
+// Results returns the series on the current page.
+func (p *SeriesPaginator) Results() []*Series {
+	return p.results
+}
+
+// SearchSeriesPages returns a SeriesPaginator over the series matching
+// searchTerm with limit (1-100) series per page.
+func (c *WS2Client) SearchSeriesPages(searchTerm string, limit int) *SeriesPaginator {
+	return c.SearchSeriesPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchSeriesPagesContext is like SearchSeriesPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchSeriesPagesContext(ctx context.Context, searchTerm string, limit int) *SeriesPaginator {
+	p := &SeriesPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchSeriesContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Series
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Series)}, nil
+	})
+	return p
+}
+
+// SearchSeriesAll collects every series matching searchTerm in a single
+// response. See Paginator.
+func (c *WS2Client) SearchSeriesAll(searchTerm string) (*SeriesSearchResponse, error) {
+	return c.SearchSeriesAllContext(context.Background(), searchTerm)
+}
+
+// SearchSeriesAllContext is like SearchSeriesAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchSeriesAllContext(ctx context.Context, searchTerm string) (*SeriesSearchResponse, error) {
+	rsp := &SeriesSearchResponse{}
+	p := c.SearchSeriesPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Series = append(rsp.Series, p.results...) })
+	return rsp, err
+}
+
+// SearchSeriesStream sends the series matching searchTerm on the returned
+// channel as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchSeriesStream(ctx context.Context, searchTerm string, limit int) (<-chan *Series, <-chan error) {
+	results := make(chan *Series)
+	p := c.SearchSeriesPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// URLPaginator pages through the URLs matching a search.
+type URLPaginator struct {
+	Paginator
+	results []*URL
+}
+
+// Results returns the URLs on the current page.
+func (p *URLPaginator) Results() []*URL {
+	return p.results
+}
+
+// SearchURLPages returns a URLPaginator over the URLs matching searchTerm with
+// limit (1-100) URLs per page.
+func (c *WS2Client) SearchURLPages(searchTerm string, limit int) *URLPaginator {
+	return c.SearchURLPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchURLPagesContext is like SearchURLPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchURLPagesContext(ctx context.Context, searchTerm string, limit int) *URLPaginator {
+	p := &URLPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchURLContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.URLs
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.URLs)}, nil
+	})
+	return p
+}
+
+// SearchURLAll collects every URL matching searchTerm in a single response. See
+// Paginator.
+func (c *WS2Client) SearchURLAll(searchTerm string) (*URLSearchResponse, error) {
+	return c.SearchURLAllContext(context.Background(), searchTerm)
+}
+
+// SearchURLAllContext is like SearchURLAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchURLAllContext(ctx context.Context, searchTerm string) (*URLSearchResponse, error) {
+	rsp := &URLSearchResponse{}
+	p := c.SearchURLPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.URLs = append(rsp.URLs, p.results...) })
+	return rsp, err
+}
+
+// SearchURLStream sends the URLs matching searchTerm on the returned channel as
+// they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchURLStream(ctx context.Context, searchTerm string, limit int) (<-chan *URL, <-chan error) {
+	results := make(chan *URL)
+	p := c.SearchURLPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
+
+// WorkPaginator pages through the results of a work search.
+type WorkPaginator struct {
+	Paginator
+	results []*Work
+}
+
+// Results returns the works on the current page.
+func (p *WorkPaginator) Results() []*Work {
+	return p.results
+}
+
+// SearchWorkPages returns a WorkPaginator over the works matching searchTerm
+// with limit (1-100) works per page.
+func (c *WS2Client) SearchWorkPages(searchTerm string, limit int) *WorkPaginator {
+	return c.SearchWorkPagesContext(context.Background(), searchTerm, limit)
+}
+
+// SearchWorkPagesContext is like SearchWorkPages but aborts the requests once ctx is done.
+func (c *WS2Client) SearchWorkPagesContext(ctx context.Context, searchTerm string, limit int) *WorkPaginator {
+	p := &WorkPaginator{}
+	p.Paginator = newPaginator(ctx, limit, func(ctx context.Context, limit, offset int) (searchPage, error) {
+		rsp, err := c.SearchWorkContext(ctx, searchTerm, WithLimit(limit), WithOffset(offset))
+		if err != nil {
+			return searchPage{}, err
+		}
+		p.results = rsp.Works
+		return searchPage{rsp.WS2ListResponse, rsp.Scores, len(rsp.Works)}, nil
+	})
+	return p
+}
+
+// SearchWorkAll collects every work matching searchTerm in a single response.
+// See Paginator.
+func (c *WS2Client) SearchWorkAll(searchTerm string) (*WorkSearchResponse, error) {
+	return c.SearchWorkAllContext(context.Background(), searchTerm)
+}
+
+// SearchWorkAllContext is like SearchWorkAll but aborts the requests once ctx is done.
+func (c *WS2Client) SearchWorkAllContext(ctx context.Context, searchTerm string) (*WorkSearchResponse, error) {
+	rsp := &WorkSearchResponse{}
+	p := c.SearchWorkPagesContext(ctx, searchTerm, maxPageSize)
+	err := p.all(&rsp.WS2ListResponse, &rsp.Scores, func() { rsp.Works = append(rsp.Works, p.results...) })
+	return rsp, err
+}
+
+// SearchWorkStream sends the works matching searchTerm on the returned channel
+// as they are fetched, limit per request. See Paginator.
+func (c *WS2Client) SearchWorkStream(ctx context.Context, searchTerm string, limit int) (<-chan *Work, <-chan error) {
+	results := make(chan *Work)
+	p := c.SearchWorkPagesContext(ctx, searchTerm, limit)
+	errc := p.stream(func(i int) bool {
+		select {
+		case results <- p.results[i]:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}, func() { close(results) })
+	return results, errc
+}
@@ -0,0 +1,203 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+)
+
+// serveArtistPages serves a search result of total artists named "Artist <n>"
+// that respects the limit and offset params.
+func serveArtistPages(total int) *int {
+	requests := 0
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		limit, err := strconv.Atoi(r.URL.Query().Get("limit"))
+		if err != nil {
+			limit = 25
+		}
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		fmt.Fprintf(w, `<metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#" xmlns:ext="http://musicbrainz.org/ns/ext#-2.0"><artist-list count="%d" offset="%d">`, total, offset)
+		for i := offset; i < offset+limit && i < total; i++ {
+			fmt.Fprintf(w, `<artist id="00000000-0000-0000-0000-%012d" ext:score="%d"><name>Artist %d</name></artist>`, i, 100-i, i)
+		}
+		fmt.Fprint(w, `</artist-list></metadata>`)
+	})
+	return &requests
+}
+
+func TestArtistPaginator(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	requests := serveArtistPages(5)
+
+	var names []string
+	p := client.SearchArtistPages("Artist", 2)
+	for p.Next() {
+		if len(p.Scores()) != len(p.Results()) {
+			t.Errorf("expected a score for every result")
+		}
+		for _, a := range p.Results() {
+			names = append(names, a.Name)
+		}
+	}
+	if err := p.Err(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(names) != 5 || names[0] != "Artist 0" || names[4] != "Artist 4" {
+		t.Errorf("unexpected results %v", names)
+	}
+	if *requests != 3 {
+		t.Errorf("expected 3 requests, got %d", *requests)
+	}
+	if p.Next() {
+		t.Error("expected Next to return false after the last page")
+	}
+}
+
+func TestPaginatorError(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+
+	p := client.SearchArtistPages("Artist", 2)
+	if p.Next() {
+		t.Error("expected Next to return false")
+	}
+	if _, ok := p.Err().(*StatusError); !ok {
+		t.Errorf("expected *StatusError, got %#v", p.Err())
+	}
+}
+
+func TestSearchArtistAll(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	requests := serveArtistPages(250)
+
+	rsp, err := client.SearchArtistAll("Artist")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rsp.Artists) != 250 || rsp.Count != 250 {
+		t.Errorf("expected 250 artists, got %d with count %d", len(rsp.Artists), rsp.Count)
+	}
+	if len(rsp.Scores) != 250 || rsp.Scores[rsp.Artists[0]] != 100 {
+		t.Errorf("expected merged scores for all artists")
+	}
+	if *requests != 3 {
+		t.Errorf("expected 3 requests, got %d", *requests)
+	}
+}
+
+func TestSearchArtistAllLimit(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveArtistPages(MaxFetchAllResults + 1)
+
+	rsp, err := client.SearchArtistAll("Artist")
+	if err != ErrTooManyResults {
+		t.Errorf("expected ErrTooManyResults, got %v", err)
+	}
+	if len(rsp.Artists) != MaxFetchAllResults {
+		t.Errorf("expected %d artists, got %d", MaxFetchAllResults, len(rsp.Artists))
+	}
+}
+
+func TestSearchArtistStream(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveArtistPages(5)
+
+	results, errc := client.SearchArtistStream(context.Background(), "Artist", 2)
+
+	n := 0
+	for a := range results {
+		if want := fmt.Sprintf("Artist %d", n); a.Name != want {
+			t.Errorf("expected %q, got %q", want, a.Name)
+		}
+		n++
+	}
+	if err := <-errc; err != nil {
+		t.Fatal(err)
+	}
+	if n != 5 {
+		t.Errorf("expected 5 artists, got %d", n)
+	}
+}
+
+func TestSearchArtistStreamCanceled(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	requests := serveArtistPages(100)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	results, errc := client.SearchArtistStream(ctx, "Artist", 2)
+
+	<-results
+	cancel()
+
+	// drain remaining results until the stream stops
+	for range results {
+	}
+	if err := <-errc; err != context.Canceled {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if *requests >= 50 {
+		t.Errorf("expected stream to stop early, got %d requests", *requests)
+	}
+}
+
+func TestSearchAreaAll(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/area", "SearchArea.xml", t)
+
+	rsp, err := client.SearchAreaAll("Gopher")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rsp.Areas) != 1 || rsp.Count != 1 || len(rsp.Scores) != 1 {
+		t.Errorf("unexpected response %+v", rsp)
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"net/url"
+)
+
+// Ping checks whether WS2 is reachable and answers with a valid response by
+// performing a minimal artist search. It bypasses the cache but respects the
+// rate limit, timeout and retry settings of the client. The returned error is
+// one of the typed errors also returned by the request methods, or a network
+// error.
+func (c *WS2Client) Ping() error {
+	return c.PingContext(context.Background())
+}
+
+// PingContext is like Ping but aborts the request once ctx is done.
+func (c *WS2Client) PingContext(ctx context.Context) error {
+
+	reqUrl := c.requestURL("/artist", url.Values{"query": {"a"}, "limit": {"1"}})
+
+	// send instead of fetch to bypass the cache
+	body, _, err := c.send(ctx, &reqUrl, nil)
+	if err != nil {
+		return err
+	}
+
+	return decodeResponse(reqUrl.String(), body, &artistListResult{})
+}
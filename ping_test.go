@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPing(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/artist", "SearchArtist.xml", map[string][]string{
+		"query": {"a"},
+		"limit": {"1"},
+	}, t)
+
+	client.EnableMetrics()
+	rec := &recordingInterceptor{}
+	client.AddInterceptor(rec)
+
+	if err := client.Ping(); err != nil {
+		t.Error(err)
+	}
+
+	if rec.before != 1 || rec.after != 1 {
+		t.Errorf("expected the interceptor to be called once, got %+v", rec)
+	}
+	if n := client.Metrics().Endpoints["/artist"].Requests; n != 1 {
+		t.Errorf("expected 1 counted request, got %d", n)
+	}
+}
+
+func TestPingError(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(w, `<?xml version="1.0" encoding="UTF-8"?><error><text>Internal server error.</text></error>`)
+	})
+
+	var e *StatusError
+	if err := client.Ping(); !errors.As(err, &e) {
+		t.Fatalf("expected *StatusError, got %#v", err)
+	}
+}
+
+func TestPingInvalidResponse(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/artist", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "<html>maintenance")
+	})
+
+	var e *XMLDecodeError
+	if err := client.Ping(); !errors.As(err, &e) {
+		t.Fatalf("expected *XMLDecodeError, got %#v", err)
+	}
+}
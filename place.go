@@ -25,19 +25,29 @@
 
 package gomusicbrainz
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+)
 
 // Place represents a building or outdoor area used for performing or producing
 // music.
 type Place struct {
-	ID          MBID          `xml:"id,attr"`
-	Type        string        `xml:"type,attr"`
-	Name        string        `xml:"name"`
-	Address     string        `xml:"address"`
-	Coordinates MBCoordinates `xml:"coordinates"`
-	Area        Area          `xml:"area"`
-	Lifespan    Lifespan      `xml:"life-span"`
-	Aliases     []*Alias      `xml:"alias-list>alias"`
+	ID             MBID               `xml:"id,attr" json:"id,omitempty"`
+	Type           string             `xml:"type,attr" json:"type,omitempty"`
+	Name           string             `xml:"name" json:"name,omitempty"`
+	Disambiguation string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	Address        string             `xml:"address" json:"address,omitempty"`
+	Coordinates    MBCoordinates      `xml:"coordinates" json:"coordinates,omitempty"`
+	Area           Area               `xml:"area" json:"area,omitempty"`
+	Lifespan       Lifespan           `xml:"life-span" json:"lifespan,omitempty"`
+	Aliases        []*Alias           `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Tags           []Tag              `xml:"tag-list>tag" json:"tags,omitempty"`
+	Genres         []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Rating         Rating             `xml:"rating" json:"rating,omitempty"`
+	UserRating     UserRating         `xml:"user-rating" json:"userRating,omitempty"`
+	Annotation     string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations      TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
 }
 
 func (mbe *Place) lookupResult() interface{} {
@@ -57,10 +67,23 @@ func (mbe *Place) Id() MBID {
 	return mbe.ID
 }
 
+// String returns the name, type, area and MBID of the place.
+func (mbe *Place) String() string {
+	return entityString(mbe.Name, mbe.ID, mbe.Type, mbe.Area.Name)
+}
+
 // LookupPlace performs a place lookup request for the given MBID.
-func (c *WS2Client) LookupPlace(id MBID, inc ...string) (*Place, error) {
+//
+// Possible inc params are aliases, tags, genres, ratings, annotation and
+// <ENTITY>-rels e.g. area-rels, place-rels, event-rels or url-rels.
+func (c *WS2Client) LookupPlace(id MBID, inc ...IncludeOption) (*Place, error) {
+	return c.LookupPlaceContext(context.Background(), id, inc...)
+}
+
+// LookupPlaceContext is like LookupPlace but aborts the request once ctx is done.
+func (c *WS2Client) LookupPlaceContext(ctx context.Context, id MBID, inc ...IncludeOption) (*Place, error) {
 	a := &Place{ID: id}
-	err := c.Lookup(a, inc...)
+	err := c.LookupContext(ctx, a, inc...)
 
 	return a, err
 }
@@ -70,6 +93,7 @@ func (c *WS2Client) LookupPlace(id MBID, inc ...string) (*Place, error) {
 // Possible search fields to provide in searchTerm are:
 //
 //	pid       the place ID
+//	place     the name of this place
 //	address   the address of this place
 //	alias     the aliases/misspellings for this area
 //	area      area name
@@ -85,10 +109,15 @@ func (c *WS2Client) LookupPlace(id MBID, inc ...string) (*Place, error) {
 // With no fields specified searchTerm searches the place, alias, address and
 // area fields. For more information visit
 // https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Place
-func (c *WS2Client) SearchPlace(searchTerm string, limit, offset int) (*PlaceSearchResponse, error) {
+func (c *WS2Client) SearchPlace(searchTerm string, opts ...SearchOption) (*PlaceSearchResponse, error) {
+	return c.SearchPlaceContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchPlaceContext is like SearchPlace but aborts the request once ctx is done.
+func (c *WS2Client) SearchPlaceContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*PlaceSearchResponse, error) {
 
 	result := placeListResult{}
-	err := c.searchRequest("/place", &result, searchTerm, limit, offset)
+	err := c.searchRequest(ctx, "/place", &result, searchTerm, opts)
 
 	rsp := PlaceSearchResponse{}
 	rsp.WS2ListResponse = result.PlaceList.WS2ListResponse
@@ -102,11 +131,49 @@ func (c *WS2Client) SearchPlace(searchTerm string, limit, offset int) (*PlaceSea
 	return &rsp, err
 }
 
-// PlaceSearchResponse is the response type returned by the SearchPlace method.
+// BrowsePlaces performs a browse request for Places linked to the entity
+// with the given MBID. The only possible linked entity is area e.g.
+//
+//	client.BrowsePlaces("area", "f03d09b3-39dc-4083-afd6-159e3f0d462f", -1, -1)
+//
+// Possible inc params are the same as for LookupPlace. Scores of the
+// returned response are not populated since browse requests are not ranked.
+func (c *WS2Client) BrowsePlaces(entity string, id MBID, limit, offset int, inc ...IncludeOption) (*PlaceSearchResponse, error) {
+	return c.BrowsePlacesContext(context.Background(), entity, id, limit, offset, inc...)
+}
+
+// BrowsePlacesContext is like BrowsePlaces but aborts the request once ctx is done.
+func (c *WS2Client) BrowsePlacesContext(ctx context.Context, entity string, id MBID, limit, offset int, inc ...IncludeOption) (*PlaceSearchResponse, error) {
+
+	result := placeListResult{}
+	err := c.browseRequest(ctx, "/place", &result, entity, id, nil, limit, offset, inc)
+
+	rsp := PlaceSearchResponse{}
+	rsp.WS2ListResponse = result.PlaceList.WS2ListResponse
+
+	for _, v := range result.PlaceList.Places {
+		rsp.Places = append(rsp.Places, v.Place)
+	}
+
+	return &rsp, err
+}
+
+// PlaceSearchResponse is the response type returned by the SearchPlace and
+// BrowsePlaces methods.
 type PlaceSearchResponse struct {
 	WS2ListResponse
-	Places []*Place
-	Scores ScoreMap
+	Places []*Place `json:"places,omitempty"`
+	Scores ScoreMap `json:"-"`
+}
+
+// HasMore reports whether there are places after the ones in r.
+func (r *PlaceSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Places))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *PlaceSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Places))
 }
 
 // ResultsWithScore returns a slice of Places with a min score.
@@ -26,9 +26,9 @@
 package gomusicbrainz
 
 import (
+	"net/url"
 	"reflect"
 	"testing"
-	"time"
 )
 
 func TestSearchPlace(t *testing.T) {
@@ -44,21 +44,15 @@ func TestSearchPlace(t *testing.T) {
 				Type:        "Studio",
 				Name:        "Chipping Norton Recording Studios",
 				Address:     "28–30 New Street, Chipping Norton",
-				Coordinates: MBCoordinates{}, // TODO cover
+				Coordinates: MBCoordinates{},
 				Area: Area{
 					ID:       "44e5e20e-8fbc-4b07-b3f2-22f2199186fd",
 					Name:     "Oxfordshire",
 					SortName: "Oxfordshire",
 				},
 				Lifespan: Lifespan{
-					Begin: BrainzTime{
-						Time:     time.Date(1971, 1, 1, 0, 0, 0, 0, time.UTC),
-						Accuracy: Year,
-					},
-					End: BrainzTime{
-						Time:     time.Date(1999, 10, 1, 0, 0, 0, 0, time.UTC),
-						Accuracy: Month,
-					},
+					Begin: mustPartialDate("1971"),
+					End:   mustPartialDate("1999-10"),
 					Ended: true,
 				},
 				// TODO Aliases: []*Alias
@@ -70,7 +64,7 @@ func TestSearchPlace(t *testing.T) {
 	defer server.Close()
 	serveTestFile("/place", "SearchPlace.xml", t)
 
-	returned, err := client.SearchPlace("chipping", -1, -1)
+	returned, err := client.SearchPlace("chipping")
 	if err != nil {
 		t.Error(err)
 	}
@@ -82,4 +76,87 @@ func TestSearchPlace(t *testing.T) {
 	if !reflect.DeepEqual(*returned, want) {
 		t.Error(requestDiff(&want, returned))
 	}
+
+	if !returned.Places[0].Coordinates.IsZero() {
+		t.Error("expected zero coordinates for a place without coordinates")
+	}
+}
+
+func TestLookupPlace(t *testing.T) {
+
+	want := Place{
+		ID:      "4352063b-a833-421b-a420-e7fb295dece0",
+		Type:    "Studio",
+		Name:    "Abbey Road Studios",
+		Address: "3 Abbey Road, St John's Wood, London, NW8 9AY",
+		Coordinates: MBCoordinates{
+			Lat: 51.531892,
+			Lng: -0.178086,
+		},
+		Area: Area{
+			ID:       "f03d09b3-39dc-4083-afd6-159e3f0d462f",
+			Name:     "London",
+			SortName: "London",
+		},
+		Lifespan: Lifespan{
+			Begin: mustPartialDate("1931-11"),
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile(
+		"/place/4352063b-a833-421b-a420-e7fb295dece0",
+		"LookupPlace.xml", t)
+
+	returned, err := client.LookupPlace("4352063b-a833-421b-a420-e7fb295dece0")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+
+	if returned.Coordinates.IsZero() {
+		t.Error("expected coordinates")
+	}
+}
+
+func TestBrowsePlaces(t *testing.T) {
+
+	want := PlaceSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		Places: []*Place{
+			{
+				ID:      "4352063b-a833-421b-a420-e7fb295dece0",
+				Type:    "Studio",
+				Name:    "Abbey Road Studios",
+				Address: "3 Abbey Road, St John's Wood, London, NW8 9AY",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/place", "BrowsePlaces.xml", url.Values{
+		"area": {"f03d09b3-39dc-4083-afd6-159e3f0d462f"},
+	}, t)
+
+	returned, err := client.BrowsePlaces(
+		"area",
+		"f03d09b3-39dc-4083-afd6-159e3f0d462f",
+		-1, -1)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
 }
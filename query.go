@@ -0,0 +1,169 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"strconv"
+	"strings"
+)
+
+// QueryBuilder constructs Lucene search terms for the Search<ENTITY> methods
+// and takes care of quoting and escaping values e.g.
+//
+//	q := NewQueryBuilder().
+//		Field("artist", "Massive Attack").
+//		And().Not().Field("type", "single").
+//		Build()
+//	// artist:"Massive Attack" AND NOT type:single
+//
+// Terms are joined with AND unless Or is called in between.
+type QueryBuilder struct {
+	terms []string
+	op    string // operator inserted before the next term
+	not   bool   // whether the next term is negated
+}
+
+// NewQueryBuilder returns an empty QueryBuilder.
+func NewQueryBuilder() *QueryBuilder {
+	return &QueryBuilder{}
+}
+
+// Field adds a term that matches value in the search field name. Values
+// consisting of several words are searched as phrase.
+func (q *QueryBuilder) Field(name, value string) *QueryBuilder {
+	return q.add(name + ":" + queryValue(value))
+}
+
+// Fuzzy adds a term that matches values in the search field name which differ
+// from value by at most distance (0-2) edits per word. The term is skipped
+// along with a preceding Or or Not if value contains no words.
+func (q *QueryBuilder) Fuzzy(field, value string, distance int) *QueryBuilder {
+	words := strings.Fields(value)
+	if len(words) == 0 {
+		q.op, q.not = "", false
+		return q
+	}
+	for i, w := range words {
+		words[i] = EscapeLuceneString(w) + "~" + strconv.Itoa(distance)
+	}
+	if len(words) == 1 {
+		return q.add(field + ":" + words[0])
+	}
+	return q.add(field + ":(" + strings.Join(words, " AND ") + ")")
+}
+
+// Range adds a term that matches values in the search field between from and
+// to inclusive, e.g. Range("date", "1990", "1999"). An empty bound is
+// unlimited.
+func (q *QueryBuilder) Range(field, from, to string) *QueryBuilder {
+	return q.add(field + ":[" + rangeBound(from) + " TO " + rangeBound(to) + "]")
+}
+
+// And joins the previous and the next term with AND. This is the default.
+func (q *QueryBuilder) And() *QueryBuilder {
+	q.op = "AND"
+	return q
+}
+
+// Or joins the previous and the next term with OR.
+func (q *QueryBuilder) Or() *QueryBuilder {
+	q.op = "OR"
+	return q
+}
+
+// Not negates the next term.
+func (q *QueryBuilder) Not() *QueryBuilder {
+	q.not = true
+	return q
+}
+
+// Build returns the search term.
+func (q *QueryBuilder) Build() string {
+	return strings.Join(q.terms, " ")
+}
+
+func (q *QueryBuilder) String() string {
+	return q.Build()
+}
+
+func (q *QueryBuilder) add(term string) *QueryBuilder {
+	if q.not {
+		term = "NOT " + term
+	}
+	if len(q.terms) > 0 {
+		op := q.op
+		if op == "" {
+			op = "AND"
+		}
+		q.terms = append(q.terms, op)
+	}
+	q.terms = append(q.terms, term)
+	q.op, q.not = "", false
+	return q
+}
+
+// queryValue escapes value or quotes it if it consists of several words.
+func queryValue(value string) string {
+	if len(strings.Fields(value)) > 1 || value == "" {
+		return quoteQuery(value)
+	}
+	return EscapeLuceneString(value)
+}
+
+func rangeBound(value string) string {
+	if value == "" {
+		return "*"
+	}
+	return queryValue(value)
+}
+
+// quoteQuery returns s as Lucene phrase.
+func quoteQuery(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
+
+// luceneSpecialChars are the characters with a special meaning in Lucene
+// queries.
+const luceneSpecialChars = `+-&|!(){}[]^"~*?:\/`
+
+// EscapeLuceneString escapes all characters of s with a special meaning in
+// Lucene queries with a backslash so that s is searched literally e.g.
+//
+//	client.SearchArtist("artist:"+EscapeLuceneString("AC/DC"), -1, -1)
+//
+// Always escape user provided input before using it in a search term, a name
+// like "Sunn O)))" breaks the query otherwise. QueryBuilder escapes values
+// automatically.
+func EscapeLuceneString(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(luceneSpecialChars, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
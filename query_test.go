@@ -0,0 +1,105 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import "testing"
+
+func TestQueryBuilder(t *testing.T) {
+
+	tests := []struct {
+		query *QueryBuilder
+		want  string
+	}{
+		{
+			NewQueryBuilder().Field("artist", "Massive Attack"),
+			`artist:"Massive Attack"`,
+		},
+		{
+			NewQueryBuilder().Field("artist", "AC/DC").Field("type", "group"),
+			`artist:AC\/DC AND type:group`,
+		},
+		{
+			NewQueryBuilder().Field("artist", `Say "Hi"`).Or().Field("alias", "Hi!"),
+			`artist:"Say \"Hi\"" OR alias:Hi\!`,
+		},
+		{
+			NewQueryBuilder().Field("release", "Protection").And().Not().Field("status", "bootleg"),
+			`release:Protection AND NOT status:bootleg`,
+		},
+		{
+			NewQueryBuilder().Fuzzy("artist", "Masive", 1),
+			`artist:Masive~1`,
+		},
+		{
+			NewQueryBuilder().Fuzzy("artist", "Masive Atack", 2),
+			`artist:(Masive~2 AND Atack~2)`,
+		},
+		{
+			NewQueryBuilder().Fuzzy("artist", " ", 1),
+			``,
+		},
+		{
+			NewQueryBuilder().Field("release", "Protection").Or().Not().Fuzzy("artist", "", 1).Field("status", "official"),
+			`release:Protection AND status:official`,
+		},
+		{
+			NewQueryBuilder().Range("date", "1990", "1999").Range("tracks", "", "10"),
+			`date:[1990 TO 1999] AND tracks:[* TO 10]`,
+		},
+		{
+			NewQueryBuilder(),
+			``,
+		},
+	}
+
+	for _, test := range tests {
+		if got := test.query.Build(); got != test.want {
+			t.Errorf("expected %s, got %s", test.want, got)
+		}
+	}
+}
+
+func TestQueryBuilderSearchFields(t *testing.T) {
+
+	got := NewQueryBuilder().
+		ArtistField(ArtistFieldName, "Massive Attack").
+		ArtistField(ArtistFieldType, "group").
+		Build()
+
+	if want := `artist:"Massive Attack" AND type:group`; got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
+
+func TestEscapeLuceneString(t *testing.T) {
+
+	in := `+-&&||!(){}[]^"~*?:\/ Sunn O)))`
+	want := `\+\-\&\&\|\|\!\(\)\{\}\[\]\^\"\~\*\?\:\\\/ Sunn O\)\)\)`
+
+	if got := EscapeLuceneString(in); got != want {
+		t.Errorf("expected %s, got %s", want, got)
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultRequestsPerSecond is the rate limit applied by NewWS2Client. It
+// complies with https://musicbrainz.org/doc/XML_Web_Service/Rate_Limiting
+const DefaultRequestsPerSecond = 1.0
+
+// rateLimiter spaces calls to wait by a fixed interval.
+type rateLimiter struct {
+	mu       sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newRateLimiter(requestsPerSecond float64) *rateLimiter {
+	l := &rateLimiter{}
+	l.setRate(requestsPerSecond)
+	return l
+}
+
+// setRate sets the allowed number of requests per second. A value <= 0
+// disables rate limiting.
+func (l *rateLimiter) setRate(requestsPerSecond float64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if requestsPerSecond <= 0 {
+		l.interval = 0
+		return
+	}
+	l.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+}
+
+// clone returns a new rateLimiter with the rate of l that does not share its
+// state with l.
+func (l *rateLimiter) clone() *rateLimiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return &rateLimiter{interval: l.interval}
+}
+
+// wait blocks until the next request may be made or ctx is done. In the latter
+// case ctx.Err() is returned and the reserved slot is given back, unless later
+// callers have already reserved the slots after it.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	slot := l.next
+	delay := slot.Sub(now)
+	l.next = slot.Add(l.interval)
+	reserved := l.next
+	l.mu.Unlock()
+
+	if err := sleepContext(ctx, delay); err != nil {
+		l.mu.Lock()
+		if l.next.Equal(reserved) {
+			l.next = slot
+		}
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
@@ -25,16 +25,31 @@
 
 package gomusicbrainz
 
-import "encoding/xml"
-
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// Recording represents a distinct piece of audio, e.g. a particular mix or
+// edit of a song. Recordings appear on one or more releases as tracks. See
+// https://musicbrainz.org/doc/Recording
 type Recording struct {
-	ID             MBID         `xml:"id,attr"`
-	Title          string       `xml:"title"`
-	Length         int          `xml:"length"`
-	Disambiguation string       `xml:"disambiguation"`
-	ArtistCredit   ArtistCredit `xml:"artist-credit"`
-
-	// TODO add refs
+	ID             MBID               `xml:"id,attr" json:"id,omitempty"`
+	Title          string             `xml:"title" json:"title,omitempty"`
+	Length         Duration           `xml:"length" json:"length,omitempty"`
+	Video          bool               `xml:"video" json:"video,omitempty"`
+	Disambiguation string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	ArtistCredit   ArtistCredit       `xml:"artist-credit" json:"artistCredit,omitempty"`
+	Releases       []*Release         `xml:"release-list>release" json:"releases,omitempty"`
+	ISRCs          []ISRC             `xml:"isrc-list>isrc" json:"isrcs,omitempty"`
+	Aliases        []*Alias           `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Tags           []Tag              `xml:"tag-list>tag" json:"tags,omitempty"`
+	Genres         []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Rating         Rating             `xml:"rating" json:"rating,omitempty"`
+	UserRating     UserRating         `xml:"user-rating" json:"userRating,omitempty"`
+	Annotation     string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations      TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
 }
 
 func (mbe *Recording) lookupResult() interface{} {
@@ -54,10 +69,29 @@ func (mbe *Recording) Id() MBID {
 	return mbe.ID
 }
 
+// String returns the title, credited artists, length and MBID of the
+// recording.
+func (mbe *Recording) String() string {
+	var length string
+	if mbe.Length > 0 {
+		length = mbe.Length.String()
+	}
+	return entityString(creditedTitle(mbe.Title, mbe.ArtistCredit), mbe.ID, length)
+}
+
 // LookupRecording performs an recording lookup request for the given MBID.
-func (c *WS2Client) LookupRecording(id MBID, inc ...string) (*Recording, error) {
+//
+// Possible inc params are artists, releases, isrcs, artist-credits, aliases,
+// tags, genres, ratings, annotation and <ENTITY>-rels e.g. work-rels or
+// url-rels.
+func (c *WS2Client) LookupRecording(id MBID, inc ...IncludeOption) (*Recording, error) {
+	return c.LookupRecordingContext(context.Background(), id, inc...)
+}
+
+// LookupRecordingContext is like LookupRecording but aborts the request once ctx is done.
+func (c *WS2Client) LookupRecordingContext(ctx context.Context, id MBID, inc ...IncludeOption) (*Recording, error) {
 	a := &Recording{ID: id}
-	err := c.Lookup(a, inc...)
+	err := c.LookupContext(ctx, a, inc...)
 
 	return a, err
 }
@@ -100,10 +134,15 @@ func (c *WS2Client) LookupRecording(id MBID, inc ...string) (*Recording, error)
 // With no fields specified searchTerm searches the recording field only. For
 // more information visit
 // http://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Recording
-func (c *WS2Client) SearchRecording(searchTerm string, limit, offset int) (*RecordingSearchResponse, error) {
+func (c *WS2Client) SearchRecording(searchTerm string, opts ...SearchOption) (*RecordingSearchResponse, error) {
+	return c.SearchRecordingContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchRecordingContext is like SearchRecording but aborts the request once ctx is done.
+func (c *WS2Client) SearchRecordingContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*RecordingSearchResponse, error) {
 
 	result := recordingListResult{}
-	err := c.searchRequest("/recording", &result, searchTerm, limit, offset)
+	err := c.searchRequest(ctx, "/recording", &result, searchTerm, opts)
 
 	rsp := RecordingSearchResponse{}
 	rsp.WS2ListResponse = result.RecordingList.WS2ListResponse
@@ -117,12 +156,71 @@ func (c *WS2Client) SearchRecording(searchTerm string, limit, offset int) (*Reco
 	return &rsp, err
 }
 
+// BrowseRecordings performs a browse request for Recordings linked to the entity
+// with the given MBID. Possible linked entities are artist, release and work e.g.
+//
+//	client.BrowseRecordings("work", "1d1ba2a1-9b49-3b5e-a1d5-1f1c2d6a6c79", -1, -1, "isrcs")
+//
+// Possible inc params are the same as for LookupRecording. Scores of the
+// returned response are not populated since browse requests are not ranked.
+func (c *WS2Client) BrowseRecordings(entity string, id MBID, limit, offset int, inc ...IncludeOption) (*RecordingSearchResponse, error) {
+	return c.BrowseRecordingsContext(context.Background(), entity, id, limit, offset, inc...)
+}
+
+// BrowseRecordingsContext is like BrowseRecordings but aborts the request once ctx is done.
+func (c *WS2Client) BrowseRecordingsContext(ctx context.Context, entity string, id MBID, limit, offset int, inc ...IncludeOption) (*RecordingSearchResponse, error) {
+
+	result := recordingListResult{}
+	err := c.browseRequest(ctx, "/recording", &result, entity, id, nil, limit, offset, inc)
+
+	rsp := RecordingSearchResponse{}
+	rsp.WS2ListResponse = result.RecordingList.WS2ListResponse
+
+	for _, v := range result.RecordingList.Recordings {
+		rsp.Recordings = append(rsp.Recordings, v.Recording)
+	}
+
+	return &rsp, err
+}
+
+// LookupByISRC performs a lookup request for all recordings associated with
+// the given ISRC. Multiple recordings can share one ISRC. Possible inc params
+// are the same as for LookupRecording e.g. artists or releases.
+func (c *WS2Client) LookupByISRC(isrc ISRC, inc ...IncludeOption) ([]*Recording, error) {
+	return c.LookupByISRCContext(context.Background(), isrc, inc...)
+}
+
+// LookupByISRCContext is like LookupByISRC but aborts the request once ctx is done.
+func (c *WS2Client) LookupByISRCContext(ctx context.Context, isrc ISRC, inc ...IncludeOption) ([]*Recording, error) {
+	if !isrc.Valid() {
+		return nil, fmt.Errorf("can't perform lookup with malformed ISRC %q.", isrc)
+	}
+
+	var result struct {
+		XMLName    xml.Name     `xml:"metadata"`
+		Recordings []*Recording `xml:"isrc>recording-list>recording"`
+	}
+	err := c.getRequest(ctx, &result, encodeInc(inc), "/isrc/"+string(isrc))
+
+	return result.Recordings, err
+}
+
 // RecordingSearchResponse is the response type returned by the SearchRecording
-// method.
+// and BrowseRecordings methods.
 type RecordingSearchResponse struct {
 	WS2ListResponse
-	Recordings []*Recording
-	Scores     ScoreMap
+	Recordings []*Recording `json:"recordings,omitempty"`
+	Scores     ScoreMap     `json:"-"`
+}
+
+// HasMore reports whether there are recordings after the ones in r.
+func (r *RecordingSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Recordings))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *RecordingSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Recordings))
 }
 
 // ResultsWithScore returns a slice of Recordings with a min score.
@@ -26,6 +26,7 @@
 package gomusicbrainz
 
 import (
+	"net/url"
 	"reflect"
 	"testing"
 )
@@ -45,7 +46,7 @@ func TestSearchRecording(t *testing.T) {
 				ArtistCredit: ArtistCredit{
 					NameCredits: []NameCredit{
 						NameCredit{
-							Artist{
+							Artist: Artist{
 								ID:       "695e75b5-c6db-43ee-abeb-2f3e50d96c3e",
 								Name:     "Imperiet",
 								SortName: "Imperiet",
@@ -53,7 +54,46 @@ func TestSearchRecording(t *testing.T) {
 						},
 					},
 				},
-				//TODO add missing fields
+				Releases: []*Release{
+					{
+						ID:     "ae050d13-7f86-495e-9918-10d8c0ac58e8",
+						Title:  "Fred",
+						Status: "Official",
+						ReleaseGroup: ReleaseGroup{
+							ID:          "d0e20525-9c3b-3f68-a130-bfca696526f2",
+							Type:        "Single",
+							PrimaryType: "Single",
+						},
+						Date:        mustPartialDate("1984-12-01"),
+						CountryCode: "SE",
+						ReleaseEvents: []ReleaseEvent{
+							{
+								Date: mustPartialDate("1984-12-01"),
+								Area: &Area{
+									ID:            "23d10872-f5ae-3f0c-bf55-332788a16ecb",
+									Name:          "Sweden",
+									SortName:      "Sweden",
+									ISO31661Codes: []ISO31661Code{"SE"},
+								},
+							},
+						},
+						Mediums: []*Medium{
+							{
+								Position:   1,
+								Format:     `7" Vinyl`,
+								TrackCount: 2,
+								Tracks: []*Track{
+									{
+										ID:     "e111dc12-8ff7-399f-94c9-32fc493a7fc9",
+										Number: "A",
+										Title:  "Fred",
+										Length: 473000,
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -62,7 +102,7 @@ func TestSearchRecording(t *testing.T) {
 	defer server.Close()
 	serveTestFile("/recording", "SearchRecording.xml", t)
 
-	returned, err := client.SearchRecording("Fred", -1, -1)
+	returned, err := client.SearchRecording("Fred")
 	if err != nil {
 		t.Error(err)
 	}
@@ -75,3 +115,119 @@ func TestSearchRecording(t *testing.T) {
 		t.Error(requestDiff(&want, returned))
 	}
 }
+
+func TestLookupRecording(t *testing.T) {
+
+	want := Recording{
+		ID:     "c3ba9785-92f0-4df4-a3c7-15d1e2d2f543",
+		Title:  "Protection",
+		Length: 471560,
+		ArtistCredit: ArtistCredit{
+			NameCredits: []NameCredit{
+				NameCredit{
+					Artist: Artist{
+						ID:       "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
+						Name:     "Massive Attack",
+						SortName: "Massive Attack",
+					},
+				},
+			},
+		},
+		ISRCs: []ISRC{
+			"GBAAA9400172",
+			"GBAAA9400173",
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile(
+		"/recording/c3ba9785-92f0-4df4-a3c7-15d1e2d2f543",
+		"LookupRecording.xml", t)
+
+	returned, err := client.LookupRecording(
+		"c3ba9785-92f0-4df4-a3c7-15d1e2d2f543",
+		"artist-credits",
+		"isrcs")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestBrowseRecordings(t *testing.T) {
+
+	want := RecordingSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		Recordings: []*Recording{
+			{
+				ID:     "c3ba9785-92f0-4df4-a3c7-15d1e2d2f543",
+				Title:  "Protection",
+				Length: 471560,
+				ISRCs:  []ISRC{"GBAAA9400172"},
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/recording", "BrowseRecordings.xml", url.Values{
+		"release": {"07832b54-8266-47d5-bb0e-62c7f2cf5da5"},
+		"inc":     {"isrcs"},
+	}, t)
+
+	returned, err := client.BrowseRecordings(
+		"release",
+		"07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+		-1, -1,
+		IncISRCs)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupByISRC(t *testing.T) {
+
+	want := []*Recording{
+		&Recording{
+			ID:     "c3ba9785-92f0-4df4-a3c7-15d1e2d2f543",
+			Title:  "Protection",
+			Length: 471560,
+		},
+		&Recording{
+			ID:     "2f9a0bd2-f67b-4abc-9a5f-53a78bf6a1b1",
+			Title:  "Protection (edit)",
+			Length: 316026,
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/isrc/GBAAA9400172", "LookupByISRC.xml", t)
+
+	returned, err := client.LookupByISRC("GBAAA9400172")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(returned, want) {
+		t.Error(requestDiff(&want, &returned))
+	}
+
+	if _, err := client.LookupByISRC("GB-AAA"); err == nil {
+		t.Error("expected error for malformed ISRC")
+	}
+}
@@ -25,29 +25,90 @@
 
 package gomusicbrainz
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"strings"
+)
 
 // Release represents a unique release (i.e. issuing) of a product on a
 // specific date with specific release information such as the country, label,
 // barcode, packaging, etc. More information at https://musicbrainz.org/doc/Release
 type Release struct {
-	ID                 MBID               `xml:"id,attr"`
-	Title              string             `xml:"title"`
-	Status             string             `xml:"status"`
-	Disambiguation     string             `xml:"disambiguation"`
-	TextRepresentation TextRepresentation `xml:"text-representation"`
-	ArtistCredit       ArtistCredit       `xml:"artist-credit"`
-	ReleaseGroup       ReleaseGroup       `xml:"release-group"`
-	Date               BrainzTime         `xml:"date"`
-	CountryCode        string             `xml:"country"`
-	Barcode            string             `xml:"barcode"`
-	Asin               string             `xml:"asin"`
-	Quality            string             `xml:"quality"`
-	LabelInfos         []LabelInfo        `xml:"label-info-list>label-info"`
-	Mediums            []*Medium          `xml:"medium-list>medium"`
-	Relations          TargetRelationsMap `xml:"relation-list"`
+	ID                 MBID               `xml:"id,attr" json:"id,omitempty"`
+	Title              string             `xml:"title" json:"title,omitempty"`
+	Status             ReleaseStatus      `xml:"status" json:"status,omitempty"`
+	Packaging          ReleasePackaging   `xml:"packaging" json:"packaging,omitempty"`
+	Disambiguation     string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	TextRepresentation TextRepresentation `xml:"text-representation" json:"textRepresentation,omitempty"`
+	ArtistCredit       ArtistCredit       `xml:"artist-credit" json:"artistCredit,omitempty"`
+	ReleaseGroup       ReleaseGroup       `xml:"release-group" json:"releaseGroup,omitempty"`
+	Date               PartialDate        `xml:"date" json:"date,omitempty"`
+	CountryCode        string             `xml:"country" json:"countryCode,omitempty"`
+	ReleaseEvents      []ReleaseEvent     `xml:"release-event-list>release-event" json:"releaseEvents,omitempty"`
+	Barcode            string             `xml:"barcode" json:"barcode,omitempty"`
+	Asin               string             `xml:"asin" json:"asin,omitempty"`
+	Quality            string             `xml:"quality" json:"quality,omitempty"`
+	LabelInfos         []LabelInfo        `xml:"label-info-list>label-info" json:"labelInfos,omitempty"`
+	Mediums            []*Medium          `xml:"medium-list>medium" json:"mediums,omitempty"`
+	Aliases            []*Alias           `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Tags               []Tag              `xml:"tag-list>tag" json:"tags,omitempty"`
+	Genres             []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Rating             Rating             `xml:"rating" json:"rating,omitempty"`
+	UserRating         UserRating         `xml:"user-rating" json:"userRating,omitempty"`
+	Annotation         string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations          TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
 }
 
+// ReleaseEvent describes when and where a release was published. Area is nil
+// if the release event has no area.
+type ReleaseEvent struct {
+	Date PartialDate `xml:"date" json:"date,omitempty"`
+	Area *Area       `xml:"area" json:"area,omitempty"`
+}
+
+// ReleaseStatus describes how "official" a release is. See
+// https://musicbrainz.org/doc/Release#Status
+type ReleaseStatus string
+
+const (
+	ReleaseStatusOfficial      ReleaseStatus = "Official"
+	ReleaseStatusPromotion     ReleaseStatus = "Promotion"
+	ReleaseStatusBootleg       ReleaseStatus = "Bootleg"
+	ReleaseStatusPseudoRelease ReleaseStatus = "Pseudo-Release"
+	ReleaseStatusWithdrawn     ReleaseStatus = "Withdrawn"
+	ReleaseStatusCancelled     ReleaseStatus = "Cancelled"
+)
+
+// ReleasePackaging describes the physical packaging that accompanies a
+// release. See https://musicbrainz.org/doc/Release/Packaging
+type ReleasePackaging string
+
+const (
+	ReleasePackagingBook                 ReleasePackaging = "Book"
+	ReleasePackagingBox                  ReleasePackaging = "Box"
+	ReleasePackagingCardboardPaperSleeve ReleasePackaging = "Cardboard/Paper Sleeve"
+	ReleasePackagingCassetteCase         ReleasePackaging = "Cassette Case"
+	ReleasePackagingDigibook             ReleasePackaging = "Digibook"
+	ReleasePackagingDigipak              ReleasePackaging = "Digipak"
+	ReleasePackagingDiscboxSlider        ReleasePackaging = "Discbox Slider"
+	ReleasePackagingFatbox               ReleasePackaging = "Fatbox"
+	ReleasePackagingGatefoldCover        ReleasePackaging = "Gatefold Cover"
+	ReleasePackagingJewelCase            ReleasePackaging = "Jewel Case"
+	ReleasePackagingKeepCase             ReleasePackaging = "Keep Case"
+	ReleasePackagingLongbox              ReleasePackaging = "Longbox"
+	ReleasePackagingMetalTin             ReleasePackaging = "Metal Tin"
+	ReleasePackagingPlasticSleeve        ReleasePackaging = "Plastic Sleeve"
+	ReleasePackagingSlidepack            ReleasePackaging = "Slidepack"
+	ReleasePackagingSlimJewelCase        ReleasePackaging = "Slim Jewel Case"
+	ReleasePackagingSnapCase             ReleasePackaging = "Snap Case"
+	ReleasePackagingSnapPack             ReleasePackaging = "SnapPack"
+	ReleasePackagingSuperJewelBox        ReleasePackaging = "Super Jewel Box"
+	ReleasePackagingOther                ReleasePackaging = "Other"
+	ReleasePackagingNone                 ReleasePackaging = "None"
+)
+
 func (mbe *Release) lookupResult() interface{} {
 	var res struct {
 		XMLName xml.Name `xml:"metadata"`
@@ -65,10 +126,44 @@ func (mbe *Release) Id() MBID {
 	return mbe.ID
 }
 
+// String returns the title, credited artists, date, country and MBID of the
+// release.
+func (mbe *Release) String() string {
+	return entityString(creditedTitle(mbe.Title, mbe.ArtistCredit), mbe.ID,
+		mbe.Date.String(), mbe.CountryCode)
+}
+
+// CatalogNumbers returns the distinct, non-empty catalog numbers of the
+// release's LabelInfos. LabelInfos are only populated if the release was
+// looked up with the labels inc param.
+func (mbe *Release) CatalogNumbers() []string {
+	var out []string
+	seen := make(map[string]bool)
+
+	for _, li := range mbe.LabelInfos {
+		if li.CatalogNumber == "" || seen[li.CatalogNumber] {
+			continue
+		}
+		seen[li.CatalogNumber] = true
+		out = append(out, li.CatalogNumber)
+	}
+	return out
+}
+
 // LookupRelease performs a release lookup request for the given MBID.
-func (c *WS2Client) LookupRelease(id MBID, inc ...string) (*Release, error) {
+//
+// Possible inc params are artists, labels, recordings, release-groups, aliases,
+// tags, genres, ratings, annotation, discids, media, artist-credits and
+// <ENTITY>-rels e.g. url-rels. The tracks of each Medium are only populated if
+// recordings is given.
+func (c *WS2Client) LookupRelease(id MBID, inc ...IncludeOption) (*Release, error) {
+	return c.LookupReleaseContext(context.Background(), id, inc...)
+}
+
+// LookupReleaseContext is like LookupRelease but aborts the request once ctx is done.
+func (c *WS2Client) LookupReleaseContext(ctx context.Context, id MBID, inc ...IncludeOption) (*Release, error) {
 	a := &Release{ID: id}
-	err := c.Lookup(a, inc...)
+	err := c.LookupContext(ctx, a, inc...)
 
 	return a, err
 }
@@ -112,10 +207,15 @@ func (c *WS2Client) LookupRelease(id MBID, inc ...string) (*Release, error) {
 // With no fields specified searchTerm searches the release field only. For
 // more information visit
 // https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Release
-func (c *WS2Client) SearchRelease(searchTerm string, limit, offset int) (*ReleaseSearchResponse, error) {
+func (c *WS2Client) SearchRelease(searchTerm string, opts ...SearchOption) (*ReleaseSearchResponse, error) {
+	return c.SearchReleaseContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchReleaseContext is like SearchRelease but aborts the request once ctx is done.
+func (c *WS2Client) SearchReleaseContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*ReleaseSearchResponse, error) {
 
 	result := releaseListResult{}
-	err := c.searchRequest("/release", &result, searchTerm, limit, offset)
+	err := c.searchRequest(ctx, "/release", &result, searchTerm, opts)
 
 	rsp := ReleaseSearchResponse{}
 	rsp.WS2ListResponse = result.ReleaseList.WS2ListResponse
@@ -129,11 +229,122 @@ func (c *WS2Client) SearchRelease(searchTerm string, limit, offset int) (*Releas
 	return &rsp, err
 }
 
-// ReleaseSearchResponse is the response type returned by the SearchRelease method.
+// BrowseReleases performs a browse request for Releases linked to the entity
+// with the given MBID. Possible linked entities are artist, label, recording,
+// release-group, track, track_artist and area e.g.
+//
+//	client.BrowseReleases("artist", "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8", -1, -1, "labels")
+//
+// Possible inc params are the same as for LookupRelease. Scores of the
+// returned response are not populated since browse requests are not ranked.
+func (c *WS2Client) BrowseReleases(entity string, id MBID, limit, offset int, inc ...IncludeOption) (*ReleaseSearchResponse, error) {
+	return c.BrowseReleasesContext(context.Background(), entity, id, limit, offset, inc...)
+}
+
+// BrowseReleasesContext is like BrowseReleases but aborts the request once ctx is done.
+func (c *WS2Client) BrowseReleasesContext(ctx context.Context, entity string, id MBID, limit, offset int, inc ...IncludeOption) (*ReleaseSearchResponse, error) {
+
+	result := releaseListResult{}
+	err := c.browseRequest(ctx, "/release", &result, entity, id, nil, limit, offset, inc)
+
+	rsp := ReleaseSearchResponse{}
+	rsp.WS2ListResponse = result.ReleaseList.WS2ListResponse
+
+	for _, v := range result.ReleaseList.Releases {
+		rsp.Releases = append(rsp.Releases, v.Release)
+	}
+
+	return &rsp, err
+}
+
+// LookupByASIN returns all releases associated with the given Amazon ASIN e.g.
+// "B000002UJQ". Since WS2 provides no lookup endpoint for ASINs this pages
+// through the results of a search for the asin field. Like SearchReleaseAll
+// it returns the first MaxFetchAllResults releases and ErrTooManyResults if
+// there are more.
+//
+// Search results carry no inc params, so if inc is given every found release
+// is looked up again with LookupRelease, which costs one additional request
+// per release. If one of these lookups fails the search result is kept for
+// that release and the first error is returned.
+func (c *WS2Client) LookupByASIN(asin string, inc ...IncludeOption) ([]*Release, error) {
+	return c.LookupByASINContext(context.Background(), asin, inc...)
+}
+
+// LookupByASINContext is like LookupByASIN but aborts the requests once ctx is done.
+func (c *WS2Client) LookupByASINContext(ctx context.Context, asin string, inc ...IncludeOption) ([]*Release, error) {
+	if asin == "" {
+		return nil, errors.New("can't perform lookup without ASIN.")
+	}
+	return c.releasesByField(ctx, "asin", asin, inc)
+}
+
+// LookupByBarcode returns all releases with the given barcode, usually an EAN
+// or UPC e.g. "724383988327". Multiple releases can share a barcode. Like
+// LookupByASIN this pages through the results of a search for the barcode
+// field, and if inc is given looks up every found release again with
+// LookupRelease since search results carry no inc params.
+func (c *WS2Client) LookupByBarcode(barcode string, inc ...IncludeOption) ([]*Release, error) {
+	return c.LookupByBarcodeContext(context.Background(), barcode, inc...)
+}
+
+// LookupByBarcodeContext is like LookupByBarcode but aborts the requests once ctx is done.
+func (c *WS2Client) LookupByBarcodeContext(ctx context.Context, barcode string, inc ...IncludeOption) ([]*Release, error) {
+	if barcode == "" {
+		return nil, errors.New("can't perform lookup without barcode.")
+	}
+	return c.releasesByField(ctx, "barcode", barcode, inc)
+}
+
+// releasesByField returns all releases whose search field exactly matches
+// value. If inc is not empty the releases are looked up with inc.
+func (c *WS2Client) releasesByField(ctx context.Context, field, value string, inc []IncludeOption) ([]*Release, error) {
+
+	quote := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	rsp, err := c.SearchReleaseAllContext(ctx, field+`:"`+quote.Replace(value)+`"`)
+	if (err != nil && err != ErrTooManyResults) || len(inc) == 0 {
+		return rsp.Releases, err
+	}
+
+	ids := make([]MBID, len(rsp.Releases))
+	for i, r := range rsp.Releases {
+		ids[i] = r.ID
+	}
+
+	releases, errs := c.LookupReleaseBatchContext(ctx, ids, inc...)
+	var lookupErr error
+	for i, r := range releases {
+		if errs[i] != nil {
+			if lookupErr == nil {
+				lookupErr = errs[i]
+			}
+			continue
+		}
+		rsp.Releases[i] = r
+	}
+	if lookupErr != nil {
+		return rsp.Releases, lookupErr
+	}
+	return rsp.Releases, err
+}
+
+// ReleaseSearchResponse is the response type returned by the SearchRelease and
+// BrowseReleases methods.
 type ReleaseSearchResponse struct {
 	WS2ListResponse
-	Releases []*Release
-	Scores   ScoreMap
+	Releases []*Release `json:"releases,omitempty"`
+	Scores   ScoreMap   `json:"-"`
+}
+
+// HasMore reports whether there are releases after the ones in r.
+func (r *ReleaseSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Releases))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *ReleaseSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Releases))
 }
 
 // ResultsWithScore returns a slice of Releases with a min score.
@@ -159,23 +370,21 @@ func OriginalRelease(releases []*Release) *Release {
 
 	for _, release := range releases {
 
-		if !release.Date.IsZero() {
+		r, o := release.Date, original.Date
+
+		if !r.IsZero() {
 
-			if release.Date.Year() < original.Date.Year() || original.Date.IsZero() {
+			if r.Year < o.Year || o.IsZero() {
 				original = release
-			} else if release.Date.Year() == original.Date.Year() &&
-				release.Date.Accuracy > Year {
+			} else if r.Year == o.Year && r.Month != nil {
 
-				if original.Date.Accuracy == Year ||
-					release.Date.Month() < original.Date.Month() {
+				if o.Month == nil || *r.Month < *o.Month {
 
 					original = release
 
-				} else if release.Date.Month() == original.Date.Month() &&
-					release.Date.Accuracy > Month {
+				} else if *r.Month == *o.Month && r.Day != nil {
 
-					if original.Date.Accuracy == Month ||
-						release.Date.Day() < original.Date.Day() {
+					if o.Day == nil || *r.Day < *o.Day {
 						original = release
 					}
 				}
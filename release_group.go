@@ -25,19 +25,71 @@
 
 package gomusicbrainz
 
-import "encoding/xml"
+import (
+	"context"
+	"encoding/xml"
+	"net/url"
+	"strings"
+)
 
 // ReleaseGroup groups several different releases into a single logical entity.
 // Every release belongs to one, and only one release group. More informations
 // at https://musicbrainz.org/doc/Release_Group
 type ReleaseGroup struct {
-	ID           MBID         `xml:"id,attr"`
-	Type         string       `xml:"type,attr"`
-	PrimaryType  string       `xml:"primary-type"`
-	Title        string       `xml:"title"`
-	ArtistCredit ArtistCredit `xml:"artist-credit"`
-	Releases     []*Release   `xml:"release-list>release"` // FIXME if important unmarshal count,attr
-	Tags         []*Tag       `xml:"tag-list>tag"`
+	ID               MBID               `xml:"id,attr" json:"id,omitempty"`
+	Type             string             `xml:"type,attr" json:"type,omitempty"`
+	PrimaryType      ReleaseGroupType   `xml:"primary-type" json:"primaryType,omitempty"`
+	SecondaryTypes   []ReleaseGroupType `xml:"secondary-type-list>secondary-type" json:"secondaryTypes,omitempty"`
+	Title            string             `xml:"title" json:"title,omitempty"`
+	Disambiguation   string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	FirstReleaseDate PartialDate        `xml:"first-release-date" json:"firstReleaseDate,omitempty"`
+	ArtistCredit     ArtistCredit       `xml:"artist-credit" json:"artistCredit,omitempty"`
+	Releases         []*Release         `xml:"release-list>release" json:"releases,omitempty"` // FIXME if important unmarshal count,attr
+	Tags             []*Tag             `xml:"tag-list>tag" json:"tags,omitempty"`
+	Aliases          []*Alias           `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Genres           []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Rating           Rating             `xml:"rating" json:"rating,omitempty"`
+	UserRating       UserRating         `xml:"user-rating" json:"userRating,omitempty"`
+	Annotation       string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations        TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
+}
+
+// ReleaseGroupType is either a primary type or a secondary type of a release
+// group. See https://musicbrainz.org/doc/Release_Group/Type
+type ReleaseGroupType string
+
+// Primary types.
+const (
+	ReleaseGroupTypeAlbum     ReleaseGroupType = "Album"
+	ReleaseGroupTypeSingle    ReleaseGroupType = "Single"
+	ReleaseGroupTypeEP        ReleaseGroupType = "EP"
+	ReleaseGroupTypeBroadcast ReleaseGroupType = "Broadcast"
+	ReleaseGroupTypeOther     ReleaseGroupType = "Other"
+)
+
+// Secondary types.
+const (
+	ReleaseGroupTypeCompilation   ReleaseGroupType = "Compilation"
+	ReleaseGroupTypeSoundtrack    ReleaseGroupType = "Soundtrack"
+	ReleaseGroupTypeSpokenword    ReleaseGroupType = "Spokenword"
+	ReleaseGroupTypeInterview     ReleaseGroupType = "Interview"
+	ReleaseGroupTypeAudiobook     ReleaseGroupType = "Audiobook"
+	ReleaseGroupTypeAudioDrama    ReleaseGroupType = "Audio drama"
+	ReleaseGroupTypeLive          ReleaseGroupType = "Live"
+	ReleaseGroupTypeRemix         ReleaseGroupType = "Remix"
+	ReleaseGroupTypeDJMix         ReleaseGroupType = "DJ-mix"
+	ReleaseGroupTypeMixtapeStreet ReleaseGroupType = "Mixtape/Street"
+)
+
+// HasSecondaryType reports whether t is one of the release group's secondary
+// types e.g. to filter compilations out of a discography.
+func (mbe *ReleaseGroup) HasSecondaryType(t ReleaseGroupType) bool {
+	for _, st := range mbe.SecondaryTypes {
+		if st == t {
+			return true
+		}
+	}
+	return false
 }
 
 func (mbe *ReleaseGroup) lookupResult() interface{} {
@@ -57,10 +109,24 @@ func (mbe *ReleaseGroup) Id() MBID {
 	return mbe.ID
 }
 
+// String returns the title, credited artists, primary type and MBID of the
+// release group.
+func (mbe *ReleaseGroup) String() string {
+	return entityString(creditedTitle(mbe.Title, mbe.ArtistCredit), mbe.ID, string(mbe.PrimaryType))
+}
+
 // LookupReleaseGroup performs a release-group lookup request for the given MBID.
-func (c *WS2Client) LookupReleaseGroup(id MBID, inc ...string) (*ReleaseGroup, error) {
+//
+// Possible inc params are artists, releases, aliases, tags, genres, ratings,
+// annotation, artist-credits and <ENTITY>-rels e.g. url-rels.
+func (c *WS2Client) LookupReleaseGroup(id MBID, inc ...IncludeOption) (*ReleaseGroup, error) {
+	return c.LookupReleaseGroupContext(context.Background(), id, inc...)
+}
+
+// LookupReleaseGroupContext is like LookupReleaseGroup but aborts the request once ctx is done.
+func (c *WS2Client) LookupReleaseGroupContext(ctx context.Context, id MBID, inc ...IncludeOption) (*ReleaseGroup, error) {
 	a := &ReleaseGroup{ID: id}
-	err := c.Lookup(a, inc...)
+	err := c.LookupContext(ctx, a, inc...)
 
 	return a, err
 }
@@ -89,10 +155,15 @@ func (c *WS2Client) LookupReleaseGroup(id MBID, inc ...string) (*ReleaseGroup, e
 // With no fields specified searchTerm searches the releasgroup field only. For
 // more information visit
 // https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Release_Group
-func (c *WS2Client) SearchReleaseGroup(searchTerm string, limit, offset int) (*ReleaseGroupSearchResponse, error) {
+func (c *WS2Client) SearchReleaseGroup(searchTerm string, opts ...SearchOption) (*ReleaseGroupSearchResponse, error) {
+	return c.SearchReleaseGroupContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchReleaseGroupContext is like SearchReleaseGroup but aborts the request once ctx is done.
+func (c *WS2Client) SearchReleaseGroupContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*ReleaseGroupSearchResponse, error) {
 
 	result := releaseGroupListResult{}
-	err := c.searchRequest("/release-group", &result, searchTerm, limit, offset)
+	err := c.searchRequest(ctx, "/release-group", &result, searchTerm, opts)
 
 	rsp := ReleaseGroupSearchResponse{}
 	rsp.WS2ListResponse = result.ReleaseGroupList.WS2ListResponse
@@ -106,12 +177,67 @@ func (c *WS2Client) SearchReleaseGroup(searchTerm string, limit, offset int) (*R
 	return &rsp, err
 }
 
+// BrowseReleaseGroups performs a browse request for ReleaseGroups linked to
+// the entity with the given MBID. Possible linked entities are artist and
+// release e.g.
+//
+//	client.BrowseReleaseGroups("artist", "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8", -1, -1, "artist-credits")
+//
+// Possible inc params are the same as for LookupReleaseGroup. Scores of the
+// returned response are not populated since browse requests are not ranked.
+func (c *WS2Client) BrowseReleaseGroups(entity string, id MBID, limit, offset int, inc ...IncludeOption) (*ReleaseGroupSearchResponse, error) {
+	return c.BrowseReleaseGroupsContext(context.Background(), entity, id, limit, offset, inc...)
+}
+
+// BrowseReleaseGroupsContext is like BrowseReleaseGroups but aborts the request once ctx is done.
+func (c *WS2Client) BrowseReleaseGroupsContext(ctx context.Context, entity string, id MBID, limit, offset int, inc ...IncludeOption) (*ReleaseGroupSearchResponse, error) {
+	return c.BrowseReleaseGroupsByTypeContext(ctx, entity, id, nil, limit, offset, inc...)
+}
+
+// BrowseReleaseGroupsByType works like BrowseReleaseGroups but only returns
+// ReleaseGroups of the given types e.g. "album" or "single". If types is empty
+// no filter is applied.
+func (c *WS2Client) BrowseReleaseGroupsByType(entity string, id MBID, types []string, limit, offset int, inc ...IncludeOption) (*ReleaseGroupSearchResponse, error) {
+	return c.BrowseReleaseGroupsByTypeContext(context.Background(), entity, id, types, limit, offset, inc...)
+}
+
+// BrowseReleaseGroupsByTypeContext is like BrowseReleaseGroupsByType but aborts the request once ctx is done.
+func (c *WS2Client) BrowseReleaseGroupsByTypeContext(ctx context.Context, entity string, id MBID, types []string, limit, offset int, inc ...IncludeOption) (*ReleaseGroupSearchResponse, error) {
+
+	var filter url.Values
+	if len(types) > 0 {
+		filter = url.Values{"type": {strings.Join(types, "|")}}
+	}
+
+	result := releaseGroupListResult{}
+	err := c.browseRequest(ctx, "/release-group", &result, entity, id, filter, limit, offset, inc)
+
+	rsp := ReleaseGroupSearchResponse{}
+	rsp.WS2ListResponse = result.ReleaseGroupList.WS2ListResponse
+
+	for _, v := range result.ReleaseGroupList.ReleaseGroups {
+		rsp.ReleaseGroups = append(rsp.ReleaseGroups, v.ReleaseGroup)
+	}
+
+	return &rsp, err
+}
+
 // ReleaseGroupSearchResponse is the response type returned by release group request
 // methods.
 type ReleaseGroupSearchResponse struct {
 	WS2ListResponse
-	ReleaseGroups []*ReleaseGroup
-	Scores        ScoreMap
+	ReleaseGroups []*ReleaseGroup `json:"releaseGroups,omitempty"`
+	Scores        ScoreMap        `json:"-"`
+}
+
+// HasMore reports whether there are release groups after the ones in r.
+func (r *ReleaseGroupSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.ReleaseGroups))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *ReleaseGroupSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.ReleaseGroups))
 }
 
 // ResultsWithScore returns a slice of ReleaseGroups with a min score.
@@ -26,6 +26,7 @@
 package gomusicbrainz
 
 import (
+	"net/url"
 	"reflect"
 	"testing"
 )
@@ -46,7 +47,7 @@ func TestSearchReleaseGroup(t *testing.T) {
 				ArtistCredit: ArtistCredit{
 					NameCredits: []NameCredit{
 						NameCredit{
-							Artist{
+							Artist: Artist{
 								ID:             "a8fa58d8-f60b-4b83-be7c-aea1af11596b",
 								Name:           "Fred Giannelli",
 								SortName:       "Giannelli, Fred",
@@ -79,7 +80,7 @@ func TestSearchReleaseGroup(t *testing.T) {
 	defer server.Close()
 	serveTestFile("/release-group", "SearchReleaseGroup.xml", t)
 
-	returned, err := client.SearchReleaseGroup("Tenance", -1, -1)
+	returned, err := client.SearchReleaseGroup("Tenance")
 	if err != nil {
 		t.Error(err)
 	}
@@ -92,3 +93,95 @@ func TestSearchReleaseGroup(t *testing.T) {
 		t.Error(requestDiff(&want, returned))
 	}
 }
+
+func TestLookupReleaseGroup(t *testing.T) {
+
+	want := ReleaseGroup{
+		ID:               "bd1bb8a8-6b05-3a81-b6ac-1fdd9c6bdbd3",
+		Type:             "Album",
+		Title:            "Mezzanine Live",
+		Disambiguation:   "unofficial",
+		FirstReleaseDate: mustPartialDate("1998-04"),
+		PrimaryType:      "Album",
+		SecondaryTypes:   []ReleaseGroupType{ReleaseGroupTypeLive, ReleaseGroupTypeCompilation},
+		ArtistCredit: ArtistCredit{
+			NameCredits: []NameCredit{
+				NameCredit{
+					Artist: Artist{
+						ID:       "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
+						Name:     "Massive Attack",
+						SortName: "Massive Attack",
+					},
+				},
+			},
+		},
+		Rating: Rating{
+			VotesCount: 4,
+			Value:      3.5,
+		},
+		UserRating: 4,
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile(
+		"/release-group/bd1bb8a8-6b05-3a81-b6ac-1fdd9c6bdbd3",
+		"LookupReleaseGroup.xml", t)
+
+	returned, err := client.LookupReleaseGroup(
+		"bd1bb8a8-6b05-3a81-b6ac-1fdd9c6bdbd3",
+		"artist-credits",
+		"ratings",
+		"user-ratings")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+
+	if !returned.HasSecondaryType(ReleaseGroupTypeCompilation) || returned.HasSecondaryType(ReleaseGroupTypeRemix) {
+		t.Errorf("unexpected secondary types %v", returned.SecondaryTypes)
+	}
+}
+
+func TestBrowseReleaseGroupsByType(t *testing.T) {
+
+	want := ReleaseGroupSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		ReleaseGroups: []*ReleaseGroup{
+			{
+				ID:          "bd1bb8a8-6b05-3a81-b6ac-1fdd9c6bdbd3",
+				Type:        "Album",
+				Title:       "Mezzanine",
+				PrimaryType: "Album",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/release-group", "BrowseReleaseGroups.xml", url.Values{
+		"artist": {"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8"},
+		"type":   {"album|ep"},
+	}, t)
+
+	returned, err := client.BrowseReleaseGroupsByType(
+		"artist",
+		"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
+		[]string{"album", "ep"},
+		-1, -1)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
@@ -26,9 +26,12 @@
 package gomusicbrainz
 
 import (
+	"fmt"
+	"net/http"
+	"net/url"
 	"reflect"
+	"strconv"
 	"testing"
-	"time"
 )
 
 func TestSearchRelease(t *testing.T) {
@@ -50,7 +53,7 @@ func TestSearchRelease(t *testing.T) {
 				ArtistCredit: ArtistCredit{
 					NameCredits: []NameCredit{
 						NameCredit{
-							Artist{
+							Artist: Artist{
 								ID:       "43bcca8b-9edc-4997-8343-122350e790bf",
 								Name:     "Fred Schneider",
 								SortName: "Schneider, Fred",
@@ -61,10 +64,7 @@ func TestSearchRelease(t *testing.T) {
 				ReleaseGroup: ReleaseGroup{
 					Type: "Album",
 				},
-				Date: BrainzTime{
-					Time:     time.Date(1991, 4, 30, 0, 0, 0, 0, time.UTC),
-					Accuracy: Day,
-				},
+				Date:        mustPartialDate("1991-04-30"),
 				CountryCode: "us",
 				Barcode:     "075992659222",
 				Asin:        "075992659222",
@@ -78,7 +78,8 @@ func TestSearchRelease(t *testing.T) {
 				},
 				Mediums: []*Medium{
 					{
-						Format: "cd",
+						Format:     "cd",
+						TrackCount: 9,
 					},
 				},
 			},
@@ -89,7 +90,7 @@ func TestSearchRelease(t *testing.T) {
 	defer server.Close()
 	serveTestFile("/release", "SearchRelease.xml", t)
 
-	returned, err := client.SearchRelease("Fred", -1, -1)
+	returned, err := client.SearchRelease("Fred")
 	if err != nil {
 		t.Error(err)
 	}
@@ -103,4 +104,305 @@ func TestSearchRelease(t *testing.T) {
 	}
 }
 
-//TODO implement Lookup test with mediums and tracks
+func TestLookupRelease(t *testing.T) {
+
+	want := Release{
+		ID:        "07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+		Title:     "Protection",
+		Status:    ReleaseStatusOfficial,
+		Packaging: ReleasePackagingJewelCase,
+		Quality:   "normal",
+		TextRepresentation: TextRepresentation{
+			Language: "eng",
+			Script:   "Latn",
+		},
+		ArtistCredit: ArtistCredit{
+			NameCredits: []NameCredit{
+				NameCredit{
+					Artist: Artist{
+						ID:       "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
+						Name:     "Massive Attack",
+						SortName: "Massive Attack",
+					},
+				},
+			},
+		},
+		Date:        mustPartialDate("1995-01-24"),
+		CountryCode: "US",
+		Barcode:     "724383988327",
+		Asin:        "B000002UJQ",
+		LabelInfos: []LabelInfo{
+			{
+				CatalogNumber: "7243 8 39883 2 7",
+				Label: &Label{
+					ID:        "2ec8fd58-cffb-4bed-bf50-c8d5a6b0daf2",
+					Name:      "Virgin",
+					SortName:  "Virgin",
+					LabelCode: 3098,
+				},
+			},
+		},
+		Mediums: []*Medium{
+			{
+				Position:   1,
+				Format:     "CD",
+				TrackCount: 2,
+				Tracks: []*Track{
+					{
+						ID:       "ad8cd5f4-b4ef-3b9e-a39b-1f1f9a77b53b",
+						Position: 1,
+						Number:   "1",
+						Title:    "Protection",
+						Length:   471560,
+						Recording: Recording{
+							ID:     "c3ba9785-92f0-4df4-a3c7-15d1e2d2f543",
+							Title:  "Protection",
+							Length: 471560,
+						},
+					},
+					{
+						ID:       "a8e6c8b8-0a1e-3c0d-9ab9-54b1ca6f9a4a",
+						Position: 2,
+						Number:   "2",
+						Title:    "Karmacoma",
+						Length:   316026,
+						Recording: Recording{
+							ID:     "2c8412f0-9353-48a2-aedb-1ad8dac9498f",
+							Title:  "Karmacoma",
+							Length: 316026,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile(
+		"/release/07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+		"LookupRelease.xml", t)
+
+	returned, err := client.LookupRelease(
+		"07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+		"artist-credits",
+		"labels",
+		"recordings")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+
+	if cat := returned.CatalogNumbers(); !reflect.DeepEqual(cat, []string{"7243 8 39883 2 7"}) {
+		t.Errorf("unexpected catalog numbers %q", cat)
+	}
+}
+
+func TestBrowseReleases(t *testing.T) {
+
+	want := ReleaseSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  2,
+			Offset: 0,
+		},
+		Releases: []*Release{
+			{
+				ID:     "07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+				Title:  "Protection",
+				Status: "Official",
+			},
+			{
+				ID:     "8a2e8a2f-8c1a-4c1e-9d5f-0c3a8d7c9c61",
+				Title:  "Mezzanine",
+				Status: "Official",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/release", "BrowseReleases.xml", url.Values{
+		"artist": {"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8"},
+	}, t)
+
+	returned, err := client.BrowseReleases(
+		"artist",
+		"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
+		-1, -1)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupByASIN(t *testing.T) {
+
+	want := []*Release{
+		&Release{
+			ID:      "07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+			Title:   "Protection",
+			Status:  ReleaseStatusOfficial,
+			Barcode: "724383988327",
+			Asin:    "B000002UJQ",
+		},
+		&Release{
+			ID:      "ad8fcbbd-18a0-4b2d-9fe5-1ee3e5b5a8cc",
+			Title:   "Protection",
+			Status:  ReleaseStatusOfficial,
+			Barcode: "724383988327",
+			Asin:    "B000002UJQ",
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/release", "LookupByASIN.xml", url.Values{
+		"query": {`asin:"B000002UJQ"`},
+		"limit": {"100"},
+	}, t)
+
+	returned, err := client.LookupByASIN("B000002UJQ")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(returned, want) {
+		t.Error(requestDiff(&want, &returned))
+	}
+}
+
+func TestLookupByASINInc(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/release", "LookupByASIN.xml", url.Values{
+		"query": {`asin:"B000002UJQ"`},
+	}, t)
+	serveTestFileWithParams(
+		"/release/07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+		"LookupRelease.xml", url.Values{
+			"inc": {"labels"},
+		}, t)
+
+	// the second release is not served, its search result must be kept.
+	returned, err := client.LookupByASIN("B000002UJQ", "labels")
+
+	if err == nil {
+		t.Error("expected an error for the failed lookup")
+	}
+
+	if len(returned) != 2 {
+		t.Fatalf("want 2 releases, got %d", len(returned))
+	}
+
+	if returned[0].Packaging != ReleasePackagingJewelCase {
+		t.Errorf("first release was not looked up, packaging is %q", returned[0].Packaging)
+	}
+
+	if returned[1].ID != "ad8fcbbd-18a0-4b2d-9fe5-1ee3e5b5a8cc" || returned[1].Packaging != "" {
+		t.Errorf("second release should be the search result, got %+v", returned[1])
+	}
+}
+
+func TestLookupByBarcode(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/release", "LookupByASIN.xml", url.Values{
+		"query": {`barcode:"724383988327"`},
+		"limit": {"100"},
+	}, t)
+
+	returned, err := client.LookupByBarcode("724383988327")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(returned) != 2 {
+		t.Fatalf("expected 2 releases, got %d", len(returned))
+	}
+	for _, r := range returned {
+		if r.Barcode != "724383988327" {
+			t.Errorf("unexpected barcode %q", r.Barcode)
+		}
+	}
+
+	if _, err := client.LookupByBarcode(""); err == nil {
+		t.Error("expected error for empty barcode")
+	}
+}
+
+func TestLookupByBarcodeInc(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/release", "LookupByASIN.xml", url.Values{
+		"query": {`barcode:"724383988327"`},
+	}, t)
+	for _, id := range []string{
+		"07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+		"ad8fcbbd-18a0-4b2d-9fe5-1ee3e5b5a8cc",
+	} {
+		serveTestFileWithParams("/release/"+id, "LookupRelease.xml", url.Values{
+			"inc": {"labels+recordings"},
+		}, t)
+	}
+
+	returned, err := client.LookupByBarcode("724383988327", "labels", "recordings")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(returned) != 2 {
+		t.Fatalf("expected 2 releases, got %d", len(returned))
+	}
+	for _, r := range returned {
+		if len(r.LabelInfos) == 0 {
+			t.Errorf("release %s was not looked up with inc", r.ID)
+		}
+	}
+}
+
+func TestLookupByBarcodePages(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	const total = 150
+	requests := 0
+	mux.HandleFunc("/release", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		fmt.Fprintf(w, `<metadata xmlns="http://musicbrainz.org/ns/mmd-2.0#"><release-list count="%d" offset="%d">`, total, offset)
+		for i := offset; i < offset+limit && i < total; i++ {
+			fmt.Fprintf(w, `<release id="00000000-0000-0000-0000-%012d"><barcode>724383988327</barcode></release>`, i)
+		}
+		fmt.Fprint(w, `</release-list></metadata>`)
+	})
+
+	returned, err := client.LookupByBarcode("724383988327")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(returned) != total {
+		t.Errorf("expected %d releases, got %d", total, len(returned))
+	}
+	if requests != 2 {
+		t.Errorf("expected 2 requests, got %d", requests)
+	}
+}
@@ -35,10 +35,10 @@ func main() {
 
 	// create a new WS2Client.
 	client, _ := gomusicbrainz.NewWS2Client(
-		"https://musicbrainz.org/ws/2",
-		"A GoMusicBrainz example",
-		"0.0.1-beta",
-		"http://github.com/michiwend/gomusicbrainz")
+		gomusicbrainz.WithAppInfo(
+			"A GoMusicBrainz example",
+			"0.0.1-beta",
+			"http://github.com/michiwend/gomusicbrainz"))
 
 	// Lookup artist by id.
 	artist, err := client.LookupArtist("10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8")
@@ -35,13 +35,13 @@ func main() {
 
 	// create a new WS2Client.
 	client, _ := gomusicbrainz.NewWS2Client(
-		"https://musicbrainz.org/ws/2",
-		"A GoMusicBrainz example",
-		"0.0.1-beta",
-		"http://github.com/michiwend/gomusicbrainz")
+		gomusicbrainz.WithAppInfo(
+			"A GoMusicBrainz example",
+			"0.0.1-beta",
+			"http://github.com/michiwend/gomusicbrainz"))
 
 	// Search for some artist(s)
-	resp, _ := client.SearchArtist(`artist:"Parov Stelar"`, -1, -1)
+	resp, _ := client.SearchArtist(`artist:"Parov Stelar"`)
 
 	// Pretty print Name and score of each returned artist.
 	for _, artist := range resp.Artists {
@@ -0,0 +1,378 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+// The following types define the search fields of each entity that can be
+// used in search terms, see the doc of the respective Search<ENTITY> method
+// and QueryBuilder.
+
+// AnnotationSearchField is a search field for SearchAnnotation.
+type AnnotationSearchField string
+
+const (
+	AnnotationFieldText   AnnotationSearchField = "text"
+	AnnotationFieldType   AnnotationSearchField = "type"
+	AnnotationFieldName   AnnotationSearchField = "name"
+	AnnotationFieldEntity AnnotationSearchField = "entity"
+)
+
+// AreaSearchField is a search field for SearchArea.
+type AreaSearchField string
+
+const (
+	AreaFieldID        AreaSearchField = "aid"
+	AreaFieldAlias     AreaSearchField = "alias"
+	AreaFieldName      AreaSearchField = "area"
+	AreaFieldBeginDate AreaSearchField = "begin"
+	AreaFieldComment   AreaSearchField = "comment"
+	AreaFieldEndDate   AreaSearchField = "end"
+	AreaFieldEnded     AreaSearchField = "ended"
+	AreaFieldSortName  AreaSearchField = "sortname"
+	AreaFieldISO       AreaSearchField = "iso"
+	AreaFieldISO1      AreaSearchField = "iso1"
+	AreaFieldISO2      AreaSearchField = "iso2"
+	AreaFieldISO3      AreaSearchField = "iso3"
+	AreaFieldType      AreaSearchField = "type"
+)
+
+// ArtistSearchField is a search field for SearchArtist.
+type ArtistSearchField string
+
+const (
+	ArtistFieldArea       ArtistSearchField = "area"
+	ArtistFieldBeginArea  ArtistSearchField = "beginarea"
+	ArtistFieldEndArea    ArtistSearchField = "endarea"
+	ArtistFieldID         ArtistSearchField = "arid"
+	ArtistFieldName       ArtistSearchField = "artist"
+	ArtistFieldNameAccent ArtistSearchField = "artistaccent"
+	ArtistFieldAlias      ArtistSearchField = "alias"
+	ArtistFieldBeginDate  ArtistSearchField = "begin"
+	ArtistFieldComment    ArtistSearchField = "comment"
+	ArtistFieldCountry    ArtistSearchField = "country"
+	ArtistFieldEndDate    ArtistSearchField = "end"
+	ArtistFieldEnded      ArtistSearchField = "ended"
+	ArtistFieldGender     ArtistSearchField = "gender"
+	ArtistFieldIPI        ArtistSearchField = "ipi"
+	ArtistFieldSortName   ArtistSearchField = "sortname"
+	ArtistFieldTag        ArtistSearchField = "tag"
+	ArtistFieldType       ArtistSearchField = "type"
+	ArtistFieldISNI       ArtistSearchField = "isni"
+)
+
+// CDStubSearchField is a search field for SearchCDStub.
+type CDStubSearchField string
+
+const (
+	CDStubFieldArtist  CDStubSearchField = "artist"
+	CDStubFieldTitle   CDStubSearchField = "title"
+	CDStubFieldBarcode CDStubSearchField = "barcode"
+	CDStubFieldComment CDStubSearchField = "comment"
+	CDStubFieldTracks  CDStubSearchField = "tracks"
+	CDStubFieldDiscID  CDStubSearchField = "discid"
+)
+
+// EventSearchField is a search field for SearchEvent.
+type EventSearchField string
+
+const (
+	EventFieldAreaID     EventSearchField = "aid"
+	EventFieldAlias      EventSearchField = "alias"
+	EventFieldArea       EventSearchField = "area"
+	EventFieldArtistID   EventSearchField = "arid"
+	EventFieldArtist     EventSearchField = "artist"
+	EventFieldBeginDate  EventSearchField = "begin"
+	EventFieldComment    EventSearchField = "comment"
+	EventFieldID         EventSearchField = "eid"
+	EventFieldEndDate    EventSearchField = "end"
+	EventFieldEnded      EventSearchField = "ended"
+	EventFieldName       EventSearchField = "event"
+	EventFieldNameAccent EventSearchField = "eventaccent"
+	EventFieldPlaceID    EventSearchField = "pid"
+	EventFieldPlace      EventSearchField = "place"
+	EventFieldTag        EventSearchField = "tag"
+	EventFieldType       EventSearchField = "type"
+)
+
+// InstrumentSearchField is a search field for SearchInstrument.
+type InstrumentSearchField string
+
+const (
+	InstrumentFieldAlias       InstrumentSearchField = "alias"
+	InstrumentFieldComment     InstrumentSearchField = "comment"
+	InstrumentFieldDescription InstrumentSearchField = "description"
+	InstrumentFieldID          InstrumentSearchField = "iid"
+	InstrumentFieldName        InstrumentSearchField = "instrument"
+	InstrumentFieldNameAccent  InstrumentSearchField = "instrumentaccent"
+	InstrumentFieldTag         InstrumentSearchField = "tag"
+	InstrumentFieldType        InstrumentSearchField = "type"
+)
+
+// LabelSearchField is a search field for SearchLabel.
+type LabelSearchField string
+
+const (
+	LabelFieldAlias      LabelSearchField = "alias"
+	LabelFieldArea       LabelSearchField = "area"
+	LabelFieldBeginDate  LabelSearchField = "begin"
+	LabelFieldCode       LabelSearchField = "code"
+	LabelFieldComment    LabelSearchField = "comment"
+	LabelFieldCountry    LabelSearchField = "country"
+	LabelFieldEndDate    LabelSearchField = "end"
+	LabelFieldEnded      LabelSearchField = "ended"
+	LabelFieldIPI        LabelSearchField = "ipi"
+	LabelFieldName       LabelSearchField = "label"
+	LabelFieldNameAccent LabelSearchField = "labelaccent"
+	LabelFieldID         LabelSearchField = "laid"
+	LabelFieldSortName   LabelSearchField = "sortname"
+	LabelFieldType       LabelSearchField = "type"
+	LabelFieldTag        LabelSearchField = "tag"
+)
+
+// PlaceSearchField is a search field for SearchPlace.
+type PlaceSearchField string
+
+const (
+	PlaceFieldID        PlaceSearchField = "pid"
+	PlaceFieldName      PlaceSearchField = "place"
+	PlaceFieldAddress   PlaceSearchField = "address"
+	PlaceFieldAlias     PlaceSearchField = "alias"
+	PlaceFieldArea      PlaceSearchField = "area"
+	PlaceFieldBeginDate PlaceSearchField = "begin"
+	PlaceFieldComment   PlaceSearchField = "comment"
+	PlaceFieldEndDate   PlaceSearchField = "end"
+	PlaceFieldEnded     PlaceSearchField = "ended"
+	PlaceFieldLatitude  PlaceSearchField = "lat"
+	PlaceFieldLongitude PlaceSearchField = "long"
+	PlaceFieldSortName  PlaceSearchField = "sortname"
+	PlaceFieldType      PlaceSearchField = "type"
+)
+
+// RecordingSearchField is a search field for SearchRecording.
+type RecordingSearchField string
+
+const (
+	RecordingFieldArtistID          RecordingSearchField = "arid"
+	RecordingFieldArtist            RecordingSearchField = "artist"
+	RecordingFieldArtistName        RecordingSearchField = "artistname"
+	RecordingFieldCreditName        RecordingSearchField = "creditname"
+	RecordingFieldComment           RecordingSearchField = "comment"
+	RecordingFieldCountry           RecordingSearchField = "country"
+	RecordingFieldDate              RecordingSearchField = "date"
+	RecordingFieldDuration          RecordingSearchField = "dur"
+	RecordingFieldFormat            RecordingSearchField = "format"
+	RecordingFieldISRC              RecordingSearchField = "isrc"
+	RecordingFieldNumber            RecordingSearchField = "number"
+	RecordingFieldPosition          RecordingSearchField = "position"
+	RecordingFieldPrimaryType       RecordingSearchField = "primarytype"
+	RecordingFieldPUID              RecordingSearchField = "puid"
+	RecordingFieldQuantizedDuration RecordingSearchField = "qdur"
+	RecordingFieldName              RecordingSearchField = "recording"
+	RecordingFieldNameAccent        RecordingSearchField = "recordingaccent"
+	RecordingFieldReleaseID         RecordingSearchField = "reid"
+	RecordingFieldRelease           RecordingSearchField = "release"
+	RecordingFieldReleaseGroupID    RecordingSearchField = "rgid"
+	RecordingFieldID                RecordingSearchField = "rid"
+	RecordingFieldSecondaryType     RecordingSearchField = "secondarytype"
+	RecordingFieldStatus            RecordingSearchField = "status"
+	RecordingFieldTrackID           RecordingSearchField = "tid"
+	RecordingFieldTrackNumber       RecordingSearchField = "tnum"
+	RecordingFieldTracks            RecordingSearchField = "tracks"
+	RecordingFieldTracksRelease     RecordingSearchField = "tracksrelease"
+	RecordingFieldTag               RecordingSearchField = "tag"
+	RecordingFieldType              RecordingSearchField = "type"
+	RecordingFieldVideo             RecordingSearchField = "video"
+)
+
+// ReleaseSearchField is a search field for SearchRelease.
+type ReleaseSearchField string
+
+const (
+	ReleaseFieldArtistID       ReleaseSearchField = "arid"
+	ReleaseFieldArtist         ReleaseSearchField = "artist"
+	ReleaseFieldArtistName     ReleaseSearchField = "artistname"
+	ReleaseFieldASIN           ReleaseSearchField = "asin"
+	ReleaseFieldBarcode        ReleaseSearchField = "barcode"
+	ReleaseFieldCatalogNumber  ReleaseSearchField = "catno"
+	ReleaseFieldComment        ReleaseSearchField = "comment"
+	ReleaseFieldCountry        ReleaseSearchField = "country"
+	ReleaseFieldCreditName     ReleaseSearchField = "creditname"
+	ReleaseFieldDate           ReleaseSearchField = "date"
+	ReleaseFieldDiscIDs        ReleaseSearchField = "discids"
+	ReleaseFieldDiscIDsMedium  ReleaseSearchField = "discidsmedium"
+	ReleaseFieldFormat         ReleaseSearchField = "format"
+	ReleaseFieldLabelID        ReleaseSearchField = "laid"
+	ReleaseFieldLabel          ReleaseSearchField = "label"
+	ReleaseFieldLanguage       ReleaseSearchField = "lang"
+	ReleaseFieldMediums        ReleaseSearchField = "mediums"
+	ReleaseFieldPrimaryType    ReleaseSearchField = "primarytype"
+	ReleaseFieldPUID           ReleaseSearchField = "puid"
+	ReleaseFieldQuality        ReleaseSearchField = "quality"
+	ReleaseFieldID             ReleaseSearchField = "reid"
+	ReleaseFieldName           ReleaseSearchField = "release"
+	ReleaseFieldNameAccent     ReleaseSearchField = "releaseaccent"
+	ReleaseFieldReleaseGroupID ReleaseSearchField = "rgid"
+	ReleaseFieldScript         ReleaseSearchField = "script"
+	ReleaseFieldSecondaryType  ReleaseSearchField = "secondarytype"
+	ReleaseFieldStatus         ReleaseSearchField = "status"
+	ReleaseFieldTag            ReleaseSearchField = "tag"
+	ReleaseFieldTracks         ReleaseSearchField = "tracks"
+	ReleaseFieldTracksMedium   ReleaseSearchField = "tracksmedium"
+	ReleaseFieldType           ReleaseSearchField = "type"
+)
+
+// ReleaseGroupSearchField is a search field for SearchReleaseGroup.
+type ReleaseGroupSearchField string
+
+const (
+	ReleaseGroupFieldArtistID      ReleaseGroupSearchField = "arid"
+	ReleaseGroupFieldArtist        ReleaseGroupSearchField = "artist"
+	ReleaseGroupFieldArtistName    ReleaseGroupSearchField = "artistname"
+	ReleaseGroupFieldComment       ReleaseGroupSearchField = "comment"
+	ReleaseGroupFieldCreditName    ReleaseGroupSearchField = "creditname"
+	ReleaseGroupFieldPrimaryType   ReleaseGroupSearchField = "primarytype"
+	ReleaseGroupFieldID            ReleaseGroupSearchField = "rgid"
+	ReleaseGroupFieldName          ReleaseGroupSearchField = "releasegroup"
+	ReleaseGroupFieldNameAccent    ReleaseGroupSearchField = "releasegroupaccent"
+	ReleaseGroupFieldReleases      ReleaseGroupSearchField = "releases"
+	ReleaseGroupFieldRelease       ReleaseGroupSearchField = "release"
+	ReleaseGroupFieldReleaseID     ReleaseGroupSearchField = "reid"
+	ReleaseGroupFieldSecondaryType ReleaseGroupSearchField = "secondarytype"
+	ReleaseGroupFieldStatus        ReleaseGroupSearchField = "status"
+	ReleaseGroupFieldTag           ReleaseGroupSearchField = "tag"
+	ReleaseGroupFieldType          ReleaseGroupSearchField = "type"
+)
+
+// SeriesSearchField is a search field for SearchSeries.
+type SeriesSearchField string
+
+const (
+	SeriesFieldAlias      SeriesSearchField = "alias"
+	SeriesFieldComment    SeriesSearchField = "comment"
+	SeriesFieldName       SeriesSearchField = "series"
+	SeriesFieldNameAccent SeriesSearchField = "seriesaccent"
+	SeriesFieldID         SeriesSearchField = "sid"
+	SeriesFieldTag        SeriesSearchField = "tag"
+	SeriesFieldType       SeriesSearchField = "type"
+)
+
+// URLSearchField is a search field for SearchURL.
+type URLSearchField string
+
+const (
+	URLFieldRelationType URLSearchField = "relationtype"
+	URLFieldTargetID     URLSearchField = "targetid"
+	URLFieldTargetType   URLSearchField = "targettype"
+	URLFieldID           URLSearchField = "uid"
+	URLFieldURL          URLSearchField = "url"
+	URLFieldURLAncestor  URLSearchField = "urlancestor"
+)
+
+// WorkSearchField is a search field for SearchWork.
+type WorkSearchField string
+
+const (
+	WorkFieldAlias      WorkSearchField = "alias"
+	WorkFieldArtistID   WorkSearchField = "arid"
+	WorkFieldArtist     WorkSearchField = "artist"
+	WorkFieldComment    WorkSearchField = "comment"
+	WorkFieldISWC       WorkSearchField = "iswc"
+	WorkFieldLanguage   WorkSearchField = "lang"
+	WorkFieldTag        WorkSearchField = "tag"
+	WorkFieldType       WorkSearchField = "type"
+	WorkFieldID         WorkSearchField = "wid"
+	WorkFieldName       WorkSearchField = "work"
+	WorkFieldNameAccent WorkSearchField = "workaccent"
+)
+
+// AnnotationField is like Field for an AnnotationSearchField.
+func (q *QueryBuilder) AnnotationField(field AnnotationSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// AreaField is like Field for an AreaSearchField.
+func (q *QueryBuilder) AreaField(field AreaSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// ArtistField is like Field for an ArtistSearchField.
+func (q *QueryBuilder) ArtistField(field ArtistSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// CDStubField is like Field for a CDStubSearchField.
+func (q *QueryBuilder) CDStubField(field CDStubSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// EventField is like Field for an EventSearchField.
+func (q *QueryBuilder) EventField(field EventSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// InstrumentField is like Field for an InstrumentSearchField.
+func (q *QueryBuilder) InstrumentField(field InstrumentSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// LabelField is like Field for a LabelSearchField.
+func (q *QueryBuilder) LabelField(field LabelSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// PlaceField is like Field for a PlaceSearchField.
+func (q *QueryBuilder) PlaceField(field PlaceSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// RecordingField is like Field for a RecordingSearchField.
+func (q *QueryBuilder) RecordingField(field RecordingSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// ReleaseField is like Field for a ReleaseSearchField.
+func (q *QueryBuilder) ReleaseField(field ReleaseSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// ReleaseGroupField is like Field for a ReleaseGroupSearchField.
+func (q *QueryBuilder) ReleaseGroupField(field ReleaseGroupSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// SeriesField is like Field for a SeriesSearchField.
+func (q *QueryBuilder) SeriesField(field SeriesSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// URLField is like Field for an URLSearchField.
+func (q *QueryBuilder) URLField(field URLSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
+
+// WorkField is like Field for a WorkSearchField.
+func (q *QueryBuilder) WorkField(field WorkSearchField, value string) *QueryBuilder {
+	return q.Field(string(field), value)
+}
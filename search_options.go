@@ -0,0 +1,63 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+// SearchOptions holds the optional parameters of a search request. A Limit or
+// Offset of -1 leaves the choice to WS2 which defaults to a limit of 25 and an
+// offset of 0. SearchOptions are set by passing WithLimit and WithOffset to
+// the Search<ENTITY> methods e.g.
+//
+//	client.SearchArtist(term, gomusicbrainz.WithLimit(10), gomusicbrainz.WithOffset(20))
+type SearchOptions struct {
+	Limit  int
+	Offset int
+}
+
+// SearchOption sets a parameter of a search request performed by one of the
+// Search<ENTITY> methods.
+type SearchOption func(*SearchOptions)
+
+// WithLimit sets the maximum number of results (1-100) of a search request.
+func WithLimit(n int) SearchOption {
+	return func(o *SearchOptions) {
+		o.Limit = n
+	}
+}
+
+// WithOffset sets the number of results of a search request to skip.
+func WithOffset(n int) SearchOption {
+	return func(o *SearchOptions) {
+		o.Offset = n
+	}
+}
+
+func newSearchOptions(opts []SearchOption) SearchOptions {
+	o := SearchOptions{Limit: -1, Offset: -1}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestSearchOptions(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/artist", "SearchArtist.xml", url.Values{
+		"query":  {"Gopher"},
+		"limit":  {"10"},
+		"offset": {"20"},
+	}, t)
+
+	returned, err := client.SearchArtist("Gopher", WithLimit(10), WithOffset(20))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(returned.Artists) != 1 {
+		t.Errorf("expected 1 artist, got %d", len(returned.Artists))
+	}
+}
+
+func TestSearchOptionsDefaults(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/artist", "SearchArtist.xml", url.Values{
+		"limit":  {""},
+		"offset": {""},
+	}, t)
+
+	if _, err := client.SearchArtist("Gopher"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"encoding/xml"
+	"sort"
+)
+
+// Series represents a sequence of separate release groups, releases,
+// recordings, works or events with a common theme. See
+// https://musicbrainz.org/doc/Series
+//
+// The items of a series are returned as relations e.g. for inc=release-group-rels
+// and are ordered by the OrderingKey of each relation.
+type Series struct {
+	ID                MBID               `xml:"id,attr" json:"id,omitempty"`
+	Type              string             `xml:"type,attr" json:"type,omitempty"`
+	Name              string             `xml:"name" json:"name,omitempty"`
+	Disambiguation    string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	OrderingAttribute string             `xml:"ordering-attribute" json:"orderingAttribute,omitempty"`
+	Aliases           []*Alias           `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Tags              []Tag              `xml:"tag-list>tag" json:"tags,omitempty"`
+	Genres            []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Annotation        string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations         TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
+}
+
+func (mbe *Series) lookupResult() interface{} {
+	var res struct {
+		XMLName xml.Name `xml:"metadata"`
+		Ptr     *Series  `xml:"series"`
+	}
+	res.Ptr = mbe
+	return &res
+}
+
+func (mbe *Series) apiEndpoint() string {
+	return "/series"
+}
+
+func (mbe *Series) Id() MBID {
+	return mbe.ID
+}
+
+// Items returns the relations of the given target type e.g. "release_group"
+// or "work" ordered by their OrderingKey. The series has to be looked up with
+// the corresponding <ENTITY>-rels inc param.
+func (mbe *Series) Items(targetType string) []Relation {
+	items := append([]Relation(nil), mbe.Relations[targetType]...)
+
+	key := func(r Relation) int {
+		if o, ok := r.(interface{ orderingKey() int }); ok {
+			return o.orderingKey()
+		}
+		return 0
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return key(items[i]) < key(items[j])
+	})
+	return items
+}
+
+// LookupSeries performs a series lookup request for the given MBID.
+//
+// Possible inc params are aliases, tags, genres, annotation and <ENTITY>-rels
+// e.g. release-group-rels, recording-rels, work-rels or event-rels which return
+// the items of the series.
+func (c *WS2Client) LookupSeries(id MBID, inc ...IncludeOption) (*Series, error) {
+	return c.LookupSeriesContext(context.Background(), id, inc...)
+}
+
+// LookupSeriesContext is like LookupSeries but aborts the request once ctx is done.
+func (c *WS2Client) LookupSeriesContext(ctx context.Context, id MBID, inc ...IncludeOption) (*Series, error) {
+	a := &Series{ID: id}
+	err := c.LookupContext(ctx, a, inc...)
+
+	return a, err
+}
+
+// SearchSeries queries MusicBrainz´ Search Server for Series.
+//
+// Possible search fields to provide in searchTerm are:
+//
+//	alias         the aliases/misspellings for the series
+//	comment       disambiguation comment
+//	series        name of the series
+//	seriesaccent  name of the series with any accent characters retained
+//	sid           MBID of the series
+//	tag           folksonomy tag
+//	type          series type (e.g. release group, event)
+//
+// With no fields specified searchTerm searches the series and alias fields.
+// For more information visit
+// https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Series
+func (c *WS2Client) SearchSeries(searchTerm string, opts ...SearchOption) (*SeriesSearchResponse, error) {
+	return c.SearchSeriesContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchSeriesContext is like SearchSeries but aborts the request once ctx is done.
+func (c *WS2Client) SearchSeriesContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*SeriesSearchResponse, error) {
+
+	result := seriesListResult{}
+	err := c.searchRequest(ctx, "/series", &result, searchTerm, opts)
+
+	rsp := SeriesSearchResponse{}
+	rsp.WS2ListResponse = result.SeriesList.WS2ListResponse
+	rsp.Scores = make(ScoreMap)
+
+	for i, v := range result.SeriesList.Series {
+		rsp.Series = append(rsp.Series, v.Series)
+		rsp.Scores[rsp.Series[i]] = v.Score
+	}
+
+	return &rsp, err
+}
+
+// SeriesSearchResponse is the response type returned by the SearchSeries
+// method.
+type SeriesSearchResponse struct {
+	WS2ListResponse
+	Series []*Series `json:"series,omitempty"`
+	Scores ScoreMap  `json:"-"`
+}
+
+// HasMore reports whether there are series after the ones in r.
+func (r *SeriesSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Series))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *SeriesSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Series))
+}
+
+// ResultsWithScore returns a slice of Series with a min score.
+func (r *SeriesSearchResponse) ResultsWithScore(score int) []*Series {
+	var res []*Series
+	for _, v := range r.Series {
+		if r.Scores[v] >= score {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+type seriesListResult struct {
+	SeriesList struct {
+		WS2ListResponse
+		Series []struct {
+			*Series
+			Score int `xml:"http://musicbrainz.org/ns/ext#-2.0 score,attr"`
+		} `xml:"series"`
+	} `xml:"series-list"`
+}
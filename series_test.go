@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchSeries(t *testing.T) {
+
+	want := SeriesSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		Series: []*Series{
+			{
+				ID:             "d977f7fd-96c9-4e3e-83b5-eb484a9e6582",
+				Type:           "Release group",
+				Name:           "Bravo Hits",
+				Disambiguation: "German compilation series",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/series", "SearchSeries.xml", t)
+
+	returned, err := client.SearchSeries("Bravo Hits")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want.Scores = ScoreMap{
+		returned.Series[0]: 100,
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupSeries(t *testing.T) {
+
+	want := Series{
+		ID:                "d977f7fd-96c9-4e3e-83b5-eb484a9e6582",
+		Type:              "Release group",
+		Name:              "Bravo Hits",
+		Disambiguation:    "German compilation series",
+		OrderingAttribute: "number",
+		Relations: TargetRelationsMap{
+			"release_group": []Relation{
+				&ReleaseGroupRelation{
+					RelationAbstract: RelationAbstract{
+						TypeID:      "01018437-91d8-36b9-bf89-3f885d53b5bd",
+						Type:        "part of",
+						Target:      "46d30a8b-2d4c-3b4f-9b1a-2d0c4cb1c5a2",
+						OrderingKey: 1,
+						Direction:   "backward",
+					},
+					ReleaseGroup: ReleaseGroup{
+						ID:          "46d30a8b-2d4c-3b4f-9b1a-2d0c4cb1c5a2",
+						Type:        "Compilation",
+						Title:       "Bravo Hits 1",
+						PrimaryType: "Album",
+					},
+				},
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile(
+		"/series/d977f7fd-96c9-4e3e-83b5-eb484a9e6582",
+		"LookupSeries.xml", t)
+
+	returned, err := client.LookupSeries(
+		"d977f7fd-96c9-4e3e-83b5-eb484a9e6582",
+		"release-group-rels")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestSeriesItems(t *testing.T) {
+
+	series := Series{
+		Relations: TargetRelationsMap{
+			"work": []Relation{
+				&WorkRelation{RelationAbstract: RelationAbstract{OrderingKey: 2}, Work: Work{Title: "second"}},
+				&WorkRelation{RelationAbstract: RelationAbstract{OrderingKey: 3}, Work: Work{Title: "third"}},
+				&WorkRelation{RelationAbstract: RelationAbstract{OrderingKey: 1}, Work: Work{Title: "first"}},
+			},
+		},
+	}
+
+	var titles []string
+	for _, item := range series.Items("work") {
+		titles = append(titles, item.TargetEntity().(*Work).Title)
+	}
+
+	if want := []string{"first", "second", "third"}; !reflect.DeepEqual(titles, want) {
+		t.Errorf("got %v, want %v", titles, want)
+	}
+	if series.Relations["work"][0].(*WorkRelation).Work.Title != "second" {
+		t.Error("Items modified the relations of the series")
+	}
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestConcurrentLookupsAreCoalesced(t *testing.T) {
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	var requests int32
+	started := make(chan struct{})
+	release := make(chan struct{})
+
+	mux.HandleFunc("/artist/", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			close(started)
+		}
+		<-release
+		http.ServeFile(w, r, "./testdata/LookupArtist.xml")
+	})
+
+	const n = 5
+	artists := make([]*Artist, n)
+	errs := make([]error, n)
+
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			artists[i], errs[i] = client.LookupArtist("10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8")
+		}(i)
+	}
+
+	<-started
+	// give the other lookups time to join the request in flight
+	time.Sleep(50 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if requests != 1 {
+		t.Errorf("expected 1 request, got %d", requests)
+	}
+	for i := 0; i < n; i++ {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+	}
+	for i := 1; i < n; i++ {
+		if artists[i] == artists[0] || artists[i].Name != artists[0].Name {
+			t.Errorf("lookup %d: expected an equal but separate artist", i)
+		}
+	}
+}
+
+func TestFlightGroupWaitAborted(t *testing.T) {
+	g := newFlightGroup()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	go g.do(context.Background(), "key", func() ([]byte, error) {
+		close(started)
+		<-release
+		return nil, nil
+	})
+	<-started
+	defer close(release)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	called := false
+	_, shared, err := g.do(ctx, "key", func() ([]byte, error) {
+		called = true
+		return nil, nil
+	})
+	if called || !shared || err != context.Canceled {
+		t.Errorf("expected to wait for the call in flight and abort, got called %v, shared %v, err %v", called, shared, err)
+	}
+}
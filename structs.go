@@ -26,7 +26,12 @@
 package gomusicbrainz
 
 import (
+	"encoding/json"
 	"encoding/xml"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -37,6 +42,101 @@ import (
 // labels, areas, places and URLs.
 type MBID string
 
+// mbidPattern matches the canonical textual representation of an UUID.
+var mbidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// ParseMBID returns s as MBID in its lower case canonical form or an error if
+// s is not a valid UUID e.g. "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8".
+func ParseMBID(s string) (MBID, error) {
+	id := MBID(strings.ToLower(strings.TrimSpace(s)))
+	if !id.Valid() {
+		return "", fmt.Errorf("invalid MBID %q.", s)
+	}
+	return id, nil
+}
+
+// Valid reports whether id is formatted as an UUID. It does not check if an
+// entity with this MBID exists.
+func (id MBID) Valid() bool {
+	return mbidPattern.MatchString(string(id))
+}
+
+func (id MBID) String() string {
+	return string(id)
+}
+
+// ISRC represents an International Standard Recording Code, a 12 character
+// code identifying a recording e.g. "USIR19400009". See
+// https://musicbrainz.org/doc/ISRC
+type ISRC string
+
+// isrcPattern matches an ISRC without hyphens: a country code, a registrant
+// code, the year of reference and a designation code.
+var isrcPattern = regexp.MustCompile(`^[A-Z]{2}[A-Z0-9]{3}[0-9]{7}$`)
+
+// ParseISRC returns s as ISRC or an error if s is not a valid ISRC. Hyphens as
+// in the display form "US-IR1-94-00009" are removed and letters are converted
+// to upper case.
+func ParseISRC(s string) (ISRC, error) {
+	i := ISRC(strings.ToUpper(strings.Replace(strings.TrimSpace(s), "-", "", -1)))
+	if !i.Valid() {
+		return "", fmt.Errorf("invalid ISRC %q.", s)
+	}
+	return i, nil
+}
+
+// Valid reports whether i is a well-formed ISRC without hyphens.
+func (i ISRC) Valid() bool {
+	return isrcPattern.MatchString(string(i))
+}
+
+func (i ISRC) String() string {
+	return string(i)
+}
+
+// UnmarshalXML is needed to implement XMLUnmarshaler since WS2 stores the code
+// in the id attribute of an isrc element.
+func (i *ISRC) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	for _, v := range start.Attr {
+		if v.Name.Local == "id" {
+			*i = ISRC(v.Value)
+			break
+		}
+	}
+	return d.Skip()
+}
+
+// ISWC represents an International Standard Musical Work Code identifying a
+// musical work e.g. "T-010.340.214-4". See https://musicbrainz.org/doc/ISWC
+type ISWC string
+
+// iswcPattern matches an ISWC without separators: the prefix T followed by nine
+// digits and a check digit.
+var iswcPattern = regexp.MustCompile(`^T[0-9]{10}$`)
+
+// ParseISWC returns s as ISWC in the form used by MusicBrainz, e.g.
+// "T-010.340.214-4", or an error if s is not a valid ISWC. s may contain any
+// or no hyphens and dots as separators.
+func ParseISWC(s string) (ISWC, error) {
+	r := strings.NewReplacer("-", "", ".", "", " ", "")
+	c := strings.ToUpper(r.Replace(strings.TrimSpace(s)))
+	if !iswcPattern.MatchString(c) {
+		return "", fmt.Errorf("invalid ISWC %q.", s)
+	}
+	return ISWC(fmt.Sprintf("T-%s.%s.%s-%s", c[1:4], c[4:7], c[7:10], c[10:])), nil
+}
+
+// Valid reports whether i is a well-formed ISWC in the form used by
+// MusicBrainz.
+func (i ISWC) Valid() bool {
+	p, err := ParseISWC(string(i))
+	return err == nil && p == i
+}
+
+func (i ISWC) String() string {
+	return string(i)
+}
+
 // MBentity is an interface implemented by all MusicBrainz entities with MBIDs.
 type MBEntity interface {
 	Id() MBID
@@ -50,17 +150,67 @@ type MBLookupEntity interface {
 	lookupResult() interface{}
 }
 
-// MBCoordinates represents a tuple of latitude,longitude values.
+// MBCoordinates represents a tuple of latitude,longitude values. It is zero if
+// the coordinates are unknown.
 type MBCoordinates struct {
-	Lat string `xml:"latitude"`
-	Lng string `xml:"longitude"`
+	Lat float64 `xml:"latitude" json:"lat,omitempty"`
+	Lng float64 `xml:"longitude" json:"lng,omitempty"`
+}
+
+// IsZero reports whether c holds no coordinates.
+func (c MBCoordinates) IsZero() bool {
+	return c.Lat == 0 && c.Lng == 0
 }
 
 // ScoreMap maps addresses of search request results to its scores.
 type ScoreMap map[interface{}]int
 
+// FilterByMinScore returns a new ScoreMap containing only the results of m
+// with a score of at least threshold e.g.
+//
+//	best := rsp.Scores.FilterByMinScore(90).TopN(5)
+func (m ScoreMap) FilterByMinScore(threshold int) ScoreMap {
+	filtered := make(ScoreMap)
+	for k, v := range m {
+		if v >= threshold {
+			filtered[k] = v
+		}
+	}
+	return filtered
+}
+
+// TopN returns up to n results of m with the highest scores in descending
+// order of their scores. The order of results with equal scores is
+// unspecified.
+func (m ScoreMap) TopN(n int) []interface{} {
+	results := make([]interface{}, 0, len(m))
+	for k := range m {
+		results = append(results, k)
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		return m[results[i]] > m[results[j]]
+	})
+
+	if n < 0 {
+		n = 0
+	}
+	if n < len(results) {
+		results = results[:n]
+	}
+	return results
+}
+
+// ISO31661Code is an ISO 3166-1 country code e.g. "GB".
+type ISO31661Code string
+
+// ISO31662Code is an ISO 3166-2 subdivision code e.g. "GB-BST".
 type ISO31662Code string
 
+// ISO31663Code is an ISO 3166-3 code of a country that has been removed from
+// ISO 3166-1 e.g. "SUHH".
+type ISO31663Code string
+
 // BrainzTimeAccuracy specifies the accuracy for the corresponding BrainzTime.
 type BrainzTimeAccuracy int
 
@@ -80,6 +230,9 @@ const (
 //	// time2 represents "2006"
 //
 //	time1.Accuracy > time2.Accuray // true
+//
+// Deprecated: The entity types use PartialDate for their dates, convert
+// existing values with BrainzTime.PartialDate.
 type BrainzTime struct {
 	time.Time
 	Accuracy BrainzTimeAccuracy
@@ -87,9 +240,20 @@ type BrainzTime struct {
 
 func (t *BrainzTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
 	var v string
-	var err error
-	d.DecodeElement(&v, &start)
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	return t.parse(v)
+}
 
+// parse sets t to the date v which may only consist of a year or a year and a
+// month. An empty v leaves t unchanged.
+func (t *BrainzTime) parse(v string) error {
+	if v == "" {
+		return nil
+	}
+
+	var err error
 	switch strings.Count(v, "-") {
 	case 0:
 		t.Time, err = time.Parse("2006", v)
@@ -105,29 +269,314 @@ func (t *BrainzTime) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error
 	return err
 }
 
+// MarshalJSON encodes t like a PartialDate e.g. "1980-05" so the accuracy is
+// preserved.
+func (t BrainzTime) MarshalJSON() ([]byte, error) {
+	return t.PartialDate().MarshalJSON()
+}
+
+func (t *BrainzTime) UnmarshalJSON(data []byte) error {
+	var v *string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*t = BrainzTime{}
+	if v == nil {
+		return nil
+	}
+	return t.parse(*v)
+}
+
+// PartialDate returns t as PartialDate that only contains the fields covered by
+// t.Accuracy. A zero BrainzTime results in a zero PartialDate.
+func (t BrainzTime) PartialDate() PartialDate {
+	if t.IsZero() {
+		return PartialDate{}
+	}
+
+	p := PartialDate{Year: t.Year()}
+	if t.Accuracy >= Month {
+		m := int(t.Month())
+		p.Month = &m
+	}
+	if t.Accuracy >= Day {
+		d := t.Day()
+		p.Day = &d
+	}
+	return p
+}
+
+// PartialDate represents a MusicBrainz date which may only consist of a year
+// e.g. "1980", a year and a month e.g. "1980-05" or a full date e.g.
+// "1980-05-21". Month and Day are nil if absent.
+type PartialDate struct {
+	Year  int
+	Month *int
+	Day   *int
+}
+
+// ParsePartialDate parses a date in one of the forms "2006", "2006-01" or
+// "2006-01-02". An empty string results in a zero PartialDate.
+func ParsePartialDate(s string) (PartialDate, error) {
+	var p PartialDate
+	if s == "" {
+		return p, nil
+	}
+
+	parts := strings.Split(s, "-")
+	if len(parts) > 3 || len(parts[0]) != 4 {
+		return p, fmt.Errorf("invalid date %q.", s)
+	}
+
+	var fields [3]int
+	for i, v := range parts {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			return p, fmt.Errorf("invalid date %q.", s)
+		}
+		fields[i] = n
+	}
+
+	p.Year = fields[0]
+	if len(parts) > 1 {
+		if fields[1] < 1 || fields[1] > 12 {
+			return p, fmt.Errorf("invalid month in date %q.", s)
+		}
+		p.Month = &fields[1]
+	}
+	if len(parts) > 2 {
+		// the zeroth day of the next month is the last day of this one
+		daysInMonth := time.Date(fields[0], time.Month(fields[1]+1), 0, 0, 0, 0, 0, time.UTC).Day()
+		if fields[2] < 1 || fields[2] > daysInMonth {
+			return p, fmt.Errorf("invalid day in date %q.", s)
+		}
+		p.Day = &fields[2]
+	}
+	return p, nil
+}
+
+// IsZero reports whether p holds no date.
+func (p PartialDate) IsZero() bool {
+	return p.Year == 0 && p.Month == nil && p.Day == nil
+}
+
+// String returns p in the form used by MusicBrainz e.g. "1980-05" or an empty
+// string if p is zero.
+func (p PartialDate) String() string {
+	if p.IsZero() {
+		return ""
+	}
+
+	s := fmt.Sprintf("%04d", p.Year)
+	if p.Month != nil {
+		s += fmt.Sprintf("-%02d", *p.Month)
+		if p.Day != nil {
+			s += fmt.Sprintf("-%02d", *p.Day)
+		}
+	}
+	return s
+}
+
+// Time returns p as time.Time in UTC. Absent fields default to the first
+// month or day respectively.
+func (p PartialDate) Time() time.Time {
+	month, day := 1, 1
+	if p.Month != nil {
+		month = *p.Month
+	}
+	if p.Day != nil {
+		day = *p.Day
+	}
+	return time.Date(p.Year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+}
+
+func (p *PartialDate) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v string
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	var err error
+	*p, err = ParsePartialDate(strings.TrimSpace(v))
+	return err
+}
+
+// UnmarshalXMLAttr is needed to implement XMLAttrUnmarshaler for dates stored
+// in attributes e.g. the begin-date of an Alias.
+func (p *PartialDate) UnmarshalXMLAttr(attr xml.Attr) error {
+	var err error
+	*p, err = ParsePartialDate(strings.TrimSpace(attr.Value))
+	return err
+}
+
+// MarshalJSON encodes p as JSON string e.g. "1980-05" or as null if p is zero.
+func (p PartialDate) MarshalJSON() ([]byte, error) {
+	if p.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(p.String())
+}
+
+func (p *PartialDate) UnmarshalJSON(data []byte) error {
+	var v *string
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	if v == nil {
+		*p = PartialDate{}
+		return nil
+	}
+
+	var err error
+	*p, err = ParsePartialDate(*v)
+	return err
+}
+
+// Duration represents the length of a recording or track in milliseconds.
+// Lengths can be compared directly e.g. rec1.Length > rec2.Length.
+type Duration int
+
+// Duration returns d as time.Duration.
+func (d Duration) Duration() time.Duration {
+	return time.Duration(d) * time.Millisecond
+}
+
+// String formats d rounded to seconds as "M:SS" or "H:MM:SS" for lengths of
+// one hour or more, e.g. "7:52".
+func (d Duration) String() string {
+	secs := (int(d) + 500) / 1000
+	if secs >= 3600 {
+		return fmt.Sprintf("%d:%02d:%02d", secs/3600, secs/60%60, secs%60)
+	}
+	return fmt.Sprintf("%d:%02d", secs/60, secs%60)
+}
+
+func (d *Duration) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var v string
+	if err := dec.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+	v = strings.TrimSpace(v)
+	if v == "" {
+		*d = 0
+		return nil
+	}
+
+	ms, err := strconv.Atoi(v)
+	if err != nil {
+		return err
+	}
+	*d = Duration(ms)
+	return nil
+}
+
 // WS2ListResponse is a abstract common type that provides the Count and Offset
 // fields for ervery list response.
 type WS2ListResponse struct {
-	Count  int `xml:"count,attr"`
-	Offset int `xml:"offset,attr"`
+	Count  int `xml:"count,attr" json:"count,omitempty"`
+	Offset int `xml:"offset,attr" json:"offset,omitempty"`
+}
+
+// TotalCount returns the total number of results across all pages.
+func (r WS2ListResponse) TotalCount() int {
+	return r.Count
+}
+
+// CurrentOffset returns the offset of the first result of the response.
+func (r WS2ListResponse) CurrentOffset() int {
+	return r.Offset
+}
+
+// nextOffset returns the offset of the page following a response with
+// pageLen results. The <ENTITY>SearchResponse types export it as NextOffset.
+func (r WS2ListResponse) nextOffset(pageLen int) int {
+	return r.Offset + pageLen
+}
+
+// hasMore reports whether there are results after a response with pageLen
+// results.
+func (r WS2ListResponse) hasMore(pageLen int) bool {
+	return r.nextOffset(pageLen) < r.Count
+}
+
+// Rating is the average rating of an entity on a scale from 0 to 5 and the
+// number of votes it is based on. See https://musicbrainz.org/doc/Rating_System
+type Rating struct {
+	VotesCount int     `xml:"votes-count,attr" json:"votesCount,omitempty"`
+	Value      float64 `xml:",chardata" json:"value,omitempty"`
+}
+
+// UserRating is the rating the authenticated user gave an entity on the same
+// 0 to 5 scale as Rating.Value.
+type UserRating float64
+
+// entityString formats an entity for display as name followed by the
+// non-empty details in brackets and the MBID e.g.
+// "Massive Attack [Group, GB] (MBID: 10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8)".
+func entityString(name string, id MBID, details ...string) string {
+	s := name
+
+	var nonEmpty []string
+	for _, d := range details {
+		if d != "" {
+			nonEmpty = append(nonEmpty, d)
+		}
+	}
+	if len(nonEmpty) > 0 {
+		s += " [" + strings.Join(nonEmpty, ", ") + "]"
+	}
+	if id != "" {
+		s += " (MBID: " + string(id) + ")"
+	}
+	return s
+}
+
+// creditedTitle returns title followed by the credited artists if any.
+func creditedTitle(title string, ac ArtistCredit) string {
+	if credit := ac.String(); credit != "" {
+		return title + " by " + credit
+	}
+	return title
 }
 
 // Lifespan represents either the life span of a natural person or more
 // generally the period of time in which an entity e.g. a Label existed.
 type Lifespan struct {
-	Begin BrainzTime `xml:"begin"`
-	End   BrainzTime `xml:"end"`
-	Ended bool       `xml:"ended"`
+	Begin PartialDate `xml:"begin" json:"begin,omitempty"`
+	End   PartialDate `xml:"end" json:"end,omitempty"`
+	Ended bool        `xml:"ended" json:"ended,omitempty"`
 }
 
-// Alias is a type for aliases/misspellings of artists, works, areas, labels
-// and places.
+// Alias is a type for aliases/misspellings of artists, works, areas, labels,
+// places, recordings, releases and release groups. BeginDate and EndDate are
+// zero unless the alias was only used for a limited period.
 type Alias struct {
-	Name     string `xml:",chardata"`
-	SortName string `xml:"sort-name,attr"`
-	Locale   string `xml:"locale,attr"`
-	Type     string `xml:"type,attr"`
-	Primary  string `xml:"primary,attr"`
+	Name      string      `xml:",chardata" json:"name,omitempty"`
+	SortName  string      `xml:"sort-name,attr" json:"sortName,omitempty"`
+	Locale    string      `xml:"locale,attr" json:"locale,omitempty"`
+	Type      string      `xml:"type,attr" json:"type,omitempty"`
+	Primary   bool        `xml:"-" json:"primary,omitempty"`
+	BeginDate PartialDate `xml:"begin-date,attr" json:"beginDate,omitempty"`
+	EndDate   PartialDate `xml:"end-date,attr" json:"endDate,omitempty"`
+}
+
+// UnmarshalXML is needed to implement XMLUnmarshaler since WS2 marks the
+// primary alias for a locale with primary="primary".
+func (a *Alias) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type alias Alias
+	var v struct {
+		alias
+		Primary string `xml:"primary,attr"`
+	}
+
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	*a = Alias(v.alias)
+	a.Primary = v.Primary == "primary"
+	return nil
 }
 
 // Medium represents one of the physical, separate things you would get when
@@ -135,36 +584,91 @@ type Alias struct {
 // always included in a release. For more information visit
 // https://musicbrainz.org/doc/Medium
 type Medium struct {
-	Format   string `xml:"format"`
-	Position int    `xml:"position"`
-	//DiscList TODO implement type
-	Tracks []*Track `xml:"track-list>track"`
+	Title    string `xml:"title" json:"title,omitempty"`
+	Format   string `xml:"format" json:"format,omitempty"`
+	Position int    `xml:"position" json:"position,omitempty"`
+	Discs    []Disc `xml:"disc-list>disc" json:"discs,omitempty"`
+	// TrackCount is the number of tracks on the medium. It is also set when
+	// the tracks themselves were not included in the response.
+	TrackCount int      `xml:"-" json:"trackCount,omitempty"`
+	Tracks     []*Track `xml:"track-list>track" json:"tracks,omitempty"`
+	// Pregap is the hidden track before the first track of a CD, if any.
+	Pregap *Track `xml:"pregap" json:"pregap,omitempty"`
+	// DataTracks are the data tracks at the end of an enhanced CD.
+	DataTracks []*Track `xml:"data-track-list>track" json:"dataTracks,omitempty"`
+}
+
+// UnmarshalXML is needed to implement XMLUnmarshaler since WS2 stores the
+// track count in the count attribute of the track-list.
+func (m *Medium) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	type medium Medium
+	var v struct {
+		medium
+		TrackList struct {
+			Count  int      `xml:"count,attr"`
+			Tracks []*Track `xml:"track"`
+		} `xml:"track-list"`
+	}
+
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	*m = Medium(v.medium)
+	m.TrackCount = v.TrackList.Count
+	m.Tracks = v.TrackList.Tracks
+	return nil
 }
 
 // Track represents a recording on a particular release (or, more exactly, on
 // a particular medium). See https://musicbrainz.org/doc/Track
 type Track struct {
-	ID        MBID      `xml:"id,attr"`
-	Position  int       `xml:"position"`
-	Number    string    `xml:"number"`
-	Length    int       `xml:"length"`
-	Recording Recording `xml:"recording"`
+	ID        MBID      `xml:"id,attr" json:"id,omitempty"`
+	Position  int       `xml:"position" json:"position,omitempty"`
+	Number    string    `xml:"number" json:"number,omitempty"`
+	Title     string    `xml:"title" json:"title,omitempty"`
+	Length    Duration  `xml:"length" json:"length,omitempty"`
+	Recording Recording `xml:"recording" json:"recording,omitempty"`
 }
 
 type TextRepresentation struct {
-	Language string `xml:"language"`
-	Script   string `xml:"script"`
+	Language string `xml:"language" json:"language,omitempty"`
+	Script   string `xml:"script" json:"script,omitempty"`
 }
 
 // ArtistCredit is either used to link multiple artists to one
 // release/recording or to credit an artist with a different name.
 // Visist https://musicbrainz.org/doc/Artist_Credit for more information.
 type ArtistCredit struct {
-	NameCredits []NameCredit `xml:"name-credit"`
+	NameCredits []NameCredit `xml:"name-credit" json:"nameCredits,omitempty"`
 }
 
+// String returns the credited names joined by their join phrases, e.g.
+// "John Lennon & Paul McCartney".
+func (ac ArtistCredit) String() string {
+	var b strings.Builder
+	for _, nc := range ac.NameCredits {
+		b.WriteString(nc.CreditedName())
+		b.WriteString(nc.JoinPhrase)
+	}
+	return b.String()
+}
+
+// NameCredit credits a single Artist within an ArtistCredit. Name is only set
+// when the artist is credited with a name other than its canonical one.
 type NameCredit struct {
-	Artist Artist `xml:"artist"`
+	Name       string `xml:"name" json:"name,omitempty"`
+	JoinPhrase string `xml:"joinphrase,attr" json:"joinPhrase,omitempty"`
+	Artist     Artist `xml:"artist" json:"artist,omitempty"`
+}
+
+// CreditedName returns the name the artist is credited as, falling back to
+// the artist's name.
+func (nc NameCredit) CreditedName() string {
+	if nc.Name != "" {
+		return nc.Name
+	}
+	return nc.Artist.Name
 }
 
 // Relation describes a relationship between different MusicBrainz entities.
@@ -172,25 +676,33 @@ type NameCredit struct {
 // relationships.
 type Relation interface {
 	TypeOf() string
+	// TargetEntity returns a pointer to the entity the relation points to
+	// e.g. *Artist for an ArtistRelation.
+	TargetEntity() interface{}
 }
 
 // RelationAbstract is the common abstract type for Relations.
 type RelationAbstract struct {
-	Type        string     `xml:"type,attr"`
-	TypeID      MBID       `xml:"type-id,attr"`
-	Target      string     `xml:"target"`
-	TargetID    MBID       `xml:"target-id,attr"`
-	OrderingKey int        `xml:"ordering-key"`
-	Direction   string     `xml:"direction"`
-	Begin       BrainzTime `xml:"begin"`
-	End         BrainzTime `xml:"end"`
-	Ended       bool       `xml:"ended"`
+	Type        string      `xml:"type,attr" json:"type,omitempty"`
+	TypeID      MBID        `xml:"type-id,attr" json:"typeId,omitempty"`
+	Target      string      `xml:"target" json:"target,omitempty"`
+	TargetID    MBID        `xml:"target-id,attr" json:"targetId,omitempty"`
+	OrderingKey int         `xml:"ordering-key" json:"orderingKey,omitempty"`
+	Direction   string      `xml:"direction" json:"direction,omitempty"`
+	Begin       PartialDate `xml:"begin" json:"begin,omitempty"`
+	End         PartialDate `xml:"end" json:"end,omitempty"`
+	Ended       bool        `xml:"ended" json:"ended,omitempty"`
+	Attributes  []string    `xml:"attribute-list>attribute" json:"attributes,omitempty"`
 }
 
 func (r *RelationAbstract) TypeOf() string {
 	return r.Type
 }
 
+func (r *RelationAbstract) orderingKey() int {
+	return r.OrderingKey
+}
+
 // RelationsOfTypes returns a slice of Relations for the given relTypes. For a
 // list of all possible relationships see https://musicbrainz.org/relationships
 func RelationsOfTypes(rels []Relation, relTypes ...string) []Relation {
@@ -212,21 +724,209 @@ type URLRelation struct {
 	RelationAbstract
 }
 
+// UnmarshalXML is needed to implement XMLUnmarshaler since the target of a
+// url-rel holds the resource as text and the MBID of the URL in its id
+// attribute.
+func (r *URLRelation) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v struct {
+		RelationAbstract
+		Target struct {
+			ID       MBID   `xml:"id,attr"`
+			Resource string `xml:",chardata"`
+		} `xml:"target"`
+	}
+	if err := d.DecodeElement(&v, &start); err != nil {
+		return err
+	}
+
+	r.RelationAbstract = v.RelationAbstract
+	r.Target = strings.TrimSpace(v.Target.Resource)
+	if v.Target.ID != "" {
+		r.TargetID = v.Target.ID
+	}
+	return nil
+}
+
+// TargetEntity returns an *URL with the ID and resource of the target.
+func (r *URLRelation) TargetEntity() interface{} {
+	return &URL{ID: r.TargetID, Resource: r.Target}
+}
+
+// LabelRelation is the Relation type for Labels.
+type LabelRelation struct {
+	RelationAbstract
+	Label Label `xml:"label" json:"label,omitempty"`
+}
+
+func (r *LabelRelation) TargetEntity() interface{} {
+	return &r.Label
+}
+
 // ReleaseRelation is the Relation type for Releases.
 type ReleaseRelation struct {
 	RelationAbstract
-	Release Release `xml:"release"`
+	Release Release `xml:"release" json:"release,omitempty"`
+}
+
+func (r *ReleaseRelation) TargetEntity() interface{} {
+	return &r.Release
 }
 
 // ArtistRelation is the Relation type for Artists.
 type ArtistRelation struct {
 	RelationAbstract
-	Artist Artist `xml:"artist"`
+	Artist Artist `xml:"artist" json:"artist,omitempty"`
+}
+
+func (r *ArtistRelation) TargetEntity() interface{} {
+	return &r.Artist
+}
+
+// AreaRelation is the Relation type for Areas.
+type AreaRelation struct {
+	RelationAbstract
+	Area Area `xml:"area" json:"area,omitempty"`
+}
+
+func (r *AreaRelation) TargetEntity() interface{} {
+	return &r.Area
+}
+
+// InstrumentRelation is the Relation type for Instruments.
+type InstrumentRelation struct {
+	RelationAbstract
+	Instrument Instrument `xml:"instrument" json:"instrument,omitempty"`
+}
+
+func (r *InstrumentRelation) TargetEntity() interface{} {
+	return &r.Instrument
+}
+
+// PlaceRelation is the Relation type for Places.
+type PlaceRelation struct {
+	RelationAbstract
+	Place Place `xml:"place" json:"place,omitempty"`
+}
+
+func (r *PlaceRelation) TargetEntity() interface{} {
+	return &r.Place
+}
+
+// EventRelation is the Relation type for Events.
+type EventRelation struct {
+	RelationAbstract
+	Event Event `xml:"event" json:"event,omitempty"`
+}
+
+func (r *EventRelation) TargetEntity() interface{} {
+	return &r.Event
+}
+
+// RecordingRelation is the Relation type for Recordings.
+type RecordingRelation struct {
+	RelationAbstract
+	Recording Recording `xml:"recording" json:"recording,omitempty"`
+}
+
+func (r *RecordingRelation) TargetEntity() interface{} {
+	return &r.Recording
+}
+
+// ReleaseGroupRelation is the Relation type for ReleaseGroups.
+type ReleaseGroupRelation struct {
+	RelationAbstract
+	ReleaseGroup ReleaseGroup `xml:"release-group" json:"releaseGroup,omitempty"`
+}
+
+func (r *ReleaseGroupRelation) TargetEntity() interface{} {
+	return &r.ReleaseGroup
+}
+
+// SeriesRelation is the Relation type for Series.
+type SeriesRelation struct {
+	RelationAbstract
+	Series Series `xml:"series" json:"series,omitempty"`
+}
+
+func (r *SeriesRelation) TargetEntity() interface{} {
+	return &r.Series
+}
+
+// WorkRelation is the Relation type for Works.
+type WorkRelation struct {
+	RelationAbstract
+	Work Work `xml:"work" json:"work,omitempty"`
+}
+
+func (r *WorkRelation) TargetEntity() interface{} {
+	return &r.Work
 }
 
 // TargetRelationsMap maps target-types to Relations.
 type TargetRelationsMap map[string][]Relation
 
+// newRelation returns a new, empty Relation for the given target type or nil
+// if the target type is not supported. It is used to decode both XML and JSON
+// so new target types only need to be added here.
+func newRelation(targetType string) Relation {
+	switch targetType {
+	case "artist":
+		return &ArtistRelation{}
+	case "area":
+		return &AreaRelation{}
+	case "instrument":
+		return &InstrumentRelation{}
+	case "place":
+		return &PlaceRelation{}
+	case "release":
+		return &ReleaseRelation{}
+	case "url":
+		return &URLRelation{}
+	case "event":
+		return &EventRelation{}
+	case "recording":
+		return &RecordingRelation{}
+	case "release_group":
+		return &ReleaseGroupRelation{}
+	case "series":
+		return &SeriesRelation{}
+	case "work":
+		return &WorkRelation{}
+	case "label":
+		return &LabelRelation{}
+	}
+	return nil
+}
+
+// UnmarshalJSON decodes relations encoded by json.Marshal. Relations of
+// unsupported target types are skipped as in UnmarshalXML.
+func (r *TargetRelationsMap) UnmarshalJSON(data []byte) error {
+	var raw map[string][]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*r = nil
+	if raw == nil {
+		return nil
+	}
+	*r = make(TargetRelationsMap)
+
+	for targetType, rels := range raw {
+		if newRelation(targetType) == nil {
+			continue
+		}
+		for _, data := range rels {
+			rel := newRelation(targetType)
+			if err := json.Unmarshal(data, rel); err != nil {
+				return err
+			}
+			(*r)[targetType] = append((*r)[targetType], rel)
+		}
+	}
+	return nil
+}
+
 // UnmarshalXML is needed to implement XMLUnmarshaler for custom, value-based
 // unmarshaling of relation-list elements.
 func (r *TargetRelationsMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
@@ -240,63 +940,40 @@ func (r *TargetRelationsMap) UnmarshalXML(d *xml.Decoder, start xml.StartElement
 		}
 	}
 
+	if newRelation(targetType) == nil {
+		return d.Skip()
+	}
+
 	if *r == nil {
 		(*r) = make(map[string][]Relation)
 	}
 
-	switch targetType {
-	case "artist":
-		var res struct {
-			XMLName   xml.Name          `xml:"relation-list"`
-			Relations []*ArtistRelation `xml:"relation"`
-		}
-		if err := d.DecodeElement(&res, &start); err != nil {
-			return err
-		}
-
-		(*r)[targetType] = make([]Relation, len(res.Relations))
-
-		for i, v := range res.Relations {
-			(*r)[targetType][i] = v
-		}
-
-	case "release":
-		var res struct {
-			XMLName   xml.Name           `xml:"relation-list"`
-			Relations []*ReleaseRelation `xml:"relation"`
-		}
+	relations := make([]Relation, 0)
 
-		if err := d.DecodeElement(&res, &start); err != nil {
+	for {
+		token, err := d.Token()
+		if err != nil {
 			return err
 		}
 
-		(*r)[targetType] = make([]Relation, len(res.Relations))
-
-		for i, v := range res.Relations {
-			(*r)[targetType][i] = v
-		}
-
-	case "url":
-		var res struct {
-			XMLName   xml.Name       `xml:"relation-list"`
-			Relations []*URLRelation `xml:"relation"`
-		}
-
-		if err := d.DecodeElement(&res, &start); err != nil {
-			return err
-		}
+		switch t := token.(type) {
+		case xml.StartElement:
+			if t.Name.Local != "relation" {
+				if err := d.Skip(); err != nil {
+					return err
+				}
+				continue
+			}
 
-		(*r)[targetType] = make([]Relation, len(res.Relations))
+			rel := newRelation(targetType)
+			if err := d.DecodeElement(rel, &t); err != nil {
+				return err
+			}
+			relations = append(relations, rel)
 
-		for i, v := range res.Relations {
-			(*r)[targetType][i] = v
+		case xml.EndElement:
+			(*r)[targetType] = relations
+			return nil
 		}
-
-	// FIXME implement missing relations
-
-	default:
-		return d.Skip()
 	}
-
-	return nil
 }
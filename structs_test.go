@@ -0,0 +1,622 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestParseMBID(t *testing.T) {
+
+	tests := []struct {
+		in   string
+		want MBID
+		ok   bool
+	}{
+		{"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8", "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8", true},
+		{" 10ADBE5E-A2C0-4BF3-8249-2B4CBF6E6CA8\n", "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8", true},
+		{"", "", false},
+		{"not-an-mbid", "", false},
+		{"10adbe5ea2c04bf382492b4cbf6e6ca8", "", false},
+		{"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8x", "", false},
+	}
+
+	for _, test := range tests {
+		got, err := ParseMBID(test.in)
+		if (err == nil) != test.ok {
+			t.Errorf("ParseMBID(%q): unexpected error %v", test.in, err)
+		}
+		if got != test.want {
+			t.Errorf("ParseMBID(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestParseISRC(t *testing.T) {
+
+	tests := []struct {
+		in   string
+		want ISRC
+		ok   bool
+	}{
+		{"USIR19400009", "USIR19400009", true},
+		{"US-IR1-94-00009", "USIR19400009", true},
+		{"gbaye6800011", "GBAYE6800011", true},
+		{"", "", false},
+		{"USIR1940000", "", false},
+		{"USIR19400009X", "", false},
+		{"1SIR19400009", "", false},
+		{"USIR194000A9", "", false},
+	}
+
+	for _, test := range tests {
+		got, err := ParseISRC(test.in)
+		if (err == nil) != test.ok {
+			t.Errorf("ParseISRC(%q): unexpected error %v", test.in, err)
+		}
+		if got != test.want {
+			t.Errorf("ParseISRC(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+}
+
+func TestParseISWC(t *testing.T) {
+
+	tests := []struct {
+		in   string
+		want ISWC
+		ok   bool
+	}{
+		{"T-010.340.214-4", "T-010.340.214-4", true},
+		{"T-010340214-4", "T-010.340.214-4", true},
+		{"T-010340214.4", "T-010.340.214-4", true},
+		{"t0103402144", "T-010.340.214-4", true},
+		{"", "", false},
+		{"T-010.340.214", "", false},
+		{"X-010.340.214-4", "", false},
+		{"T-010.340.21A-4", "", false},
+	}
+
+	for _, test := range tests {
+		got, err := ParseISWC(test.in)
+		if (err == nil) != test.ok {
+			t.Errorf("ParseISWC(%q): unexpected error %v", test.in, err)
+		}
+		if got != test.want {
+			t.Errorf("ParseISWC(%q) = %q, want %q", test.in, got, test.want)
+		}
+	}
+
+	if ISWC("T0103402144").Valid() {
+		t.Error("expected ISWC without separators not to be valid")
+	}
+}
+
+func TestPartialDate(t *testing.T) {
+
+	tests := []struct {
+		in      string
+		year    int
+		month   int // 0 if absent
+		day     int // 0 if absent
+		invalid bool
+	}{
+		{in: "1980", year: 1980},
+		{in: "1980-05", year: 1980, month: 5},
+		{in: "1980-05-21", year: 1980, month: 5, day: 21},
+		{in: ""},
+		{in: "80", invalid: true},
+		{in: "1980-13", invalid: true},
+		{in: "1980-05-00", invalid: true},
+		{in: "1980-02-29", year: 1980, month: 2, day: 29},
+		{in: "1981-02-29", invalid: true},
+		{in: "1980-02-31", invalid: true},
+		{in: "1981-04-31", invalid: true},
+		{in: "1980-05-21-01", invalid: true},
+		{in: "May 1980", invalid: true},
+	}
+
+	for _, test := range tests {
+		var v struct {
+			Date PartialDate `xml:"date"`
+		}
+		err := xml.Unmarshal([]byte("<v><date>"+test.in+"</date></v>"), &v)
+		if test.invalid {
+			if err == nil {
+				t.Errorf("%q: expected error", test.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", test.in, err)
+			continue
+		}
+
+		p := v.Date
+		if p.Year != test.year ||
+			(p.Month == nil) != (test.month == 0) || (p.Month != nil && *p.Month != test.month) ||
+			(p.Day == nil) != (test.day == 0) || (p.Day != nil && *p.Day != test.day) {
+			t.Errorf("%q: unexpected result %v", test.in, p)
+		}
+		if p.String() != test.in {
+			t.Errorf("%q: String() returned %q", test.in, p.String())
+		}
+
+		b, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var back PartialDate
+		if err := json.Unmarshal(b, &back); err != nil || back.String() != test.in {
+			t.Errorf("%q: JSON round trip returned %q, %v", test.in, back.String(), err)
+		}
+	}
+}
+
+func TestBrainzTimePartialDate(t *testing.T) {
+
+	bt := BrainzTime{
+		Time:     time.Date(1980, 5, 1, 0, 0, 0, 0, time.UTC),
+		Accuracy: Month,
+	}
+
+	if got := bt.PartialDate().String(); got != "1980-05" {
+		t.Errorf("expected 1980-05, got %q", got)
+	}
+	if !(BrainzTime{}).PartialDate().IsZero() {
+		t.Error("expected zero BrainzTime to result in zero PartialDate")
+	}
+}
+
+func TestDuration(t *testing.T) {
+
+	tests := []struct {
+		in   string
+		want Duration
+		str  string
+	}{
+		{"471560", 471560, "7:52"},
+		{"59499", 59499, "0:59"},
+		{"3600000", 3600000, "1:00:00"},
+		{"", 0, "0:00"},
+	}
+
+	for _, test := range tests {
+		var v struct {
+			Length Duration `xml:"length"`
+		}
+		if err := xml.Unmarshal([]byte("<v><length>"+test.in+"</length></v>"), &v); err != nil {
+			t.Errorf("%q: unexpected error %v", test.in, err)
+			continue
+		}
+		if v.Length != test.want {
+			t.Errorf("%q: expected %d, got %d", test.in, test.want, v.Length)
+		}
+		if v.Length.String() != test.str {
+			t.Errorf("%q: expected %q, got %q", test.in, test.str, v.Length.String())
+		}
+	}
+
+	if d := Duration(1500).Duration(); d != 1500*time.Millisecond {
+		t.Errorf("expected 1.5s, got %v", d)
+	}
+}
+
+func TestScoreMapTopN(t *testing.T) {
+
+	a, b, c := &Artist{Name: "a"}, &Artist{Name: "b"}, &Artist{Name: "c"}
+	m := ScoreMap{a: 50, b: 100, c: 75}
+
+	top := m.TopN(2)
+	if len(top) != 2 || top[0] != b || top[1] != c {
+		t.Errorf("unexpected top results %v", top)
+	}
+	if len(m.TopN(10)) != 3 {
+		t.Error("expected all results if n exceeds the number of results")
+	}
+	if len(m.TopN(0)) != 0 || len(m.TopN(-1)) != 0 {
+		t.Error("expected no results for n <= 0")
+	}
+}
+
+func TestScoreMapFilterByMinScore(t *testing.T) {
+
+	a, b, c := &Artist{Name: "a"}, &Artist{Name: "b"}, &Artist{Name: "c"}
+	m := ScoreMap{a: 50, b: 100, c: 90}
+
+	filtered := m.FilterByMinScore(90)
+	if len(filtered) != 2 || filtered[b] != 100 || filtered[c] != 90 {
+		t.Errorf("unexpected filtered results %v", filtered)
+	}
+	if len(m) != 3 {
+		t.Error("expected the original ScoreMap to be unchanged")
+	}
+	if top := m.FilterByMinScore(90).TopN(1); len(top) != 1 || top[0] != b {
+		t.Errorf("unexpected chained result %v", top)
+	}
+}
+
+func TestWS2ListResponse(t *testing.T) {
+
+	r := &ArtistSearchResponse{
+		WS2ListResponse: WS2ListResponse{Count: 30, Offset: 10},
+		Artists:         make([]*Artist, 10),
+	}
+
+	if r.TotalCount() != 30 || r.CurrentOffset() != 10 || r.NextOffset() != 20 || !r.HasMore() {
+		t.Errorf("unexpected pagination state %+v", r.WS2ListResponse)
+	}
+
+	r.Offset = 20
+	if r.NextOffset() != 30 || r.HasMore() {
+		t.Errorf("expected last page for %+v", r.WS2ListResponse)
+	}
+
+	var decoded ReleaseSearchResponse
+	if err := json.Unmarshal([]byte(`{"count":3,"offset":0,"releases":[{"id":"a"},{"id":"b"}]}`), &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.NextOffset() != 2 || !decoded.HasMore() {
+		t.Errorf("unexpected pagination state of decoded response %+v", decoded.WS2ListResponse)
+	}
+}
+
+func TestLabelRelations(t *testing.T) {
+
+	var v struct {
+		Relations TargetRelationsMap `xml:"relation-list"`
+	}
+	data := `<artist><relation-list target-type="label">
+		<relation type="recording contract" type-id="b336d682-592a-4486-a65e-bf4d5ca7ab8b">
+			<target>d92b5b4a-6aa8-4ac1-92da-6d8c1b3e4e01</target>
+			<direction>forward</direction>
+			<label id="d92b5b4a-6aa8-4ac1-92da-6d8c1b3e4e01"><name>Circa</name></label>
+		</relation>
+	</relation-list></artist>`
+
+	if err := xml.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	rels := v.Relations["label"]
+	if len(rels) != 1 {
+		t.Fatalf("expected 1 label relation, got %d", len(rels))
+	}
+	label, ok := rels[0].TargetEntity().(*Label)
+	if !ok || label.Name != "Circa" {
+		t.Errorf("unexpected target entity %#v", rels[0].TargetEntity())
+	}
+}
+
+func TestURLRelationTargetEntity(t *testing.T) {
+
+	data, err := ioutil.ReadFile("./testdata/LookupInstrument.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var v struct {
+		Instrument Instrument `xml:"instrument"`
+	}
+	if err := xml.Unmarshal(data, &v); err != nil {
+		t.Fatal(err)
+	}
+
+	rels := v.Instrument.Relations["url"]
+	if len(rels) != 1 {
+		t.Fatalf("expected 1 url relation, got %d", len(rels))
+	}
+
+	u, ok := rels[0].TargetEntity().(*URL)
+	if !ok || u.Resource != "https://www.wikidata.org/wiki/Q6607" || u.ID != "3b6e5a4e-3d9a-4a9b-9c9d-3c4a0c0c3a52" {
+		t.Errorf("unexpected target entity %#v", rels[0].TargetEntity())
+	}
+}
+
+func TestArtistCredit(t *testing.T) {
+
+	var ac ArtistCredit
+	data := `<artist-credit>
+		<name-credit joinphrase=" &amp; ">
+			<artist id="4d5447d7-c61c-4120-ba1b-d7f471d385b9"><name>John Lennon</name></artist>
+		</name-credit>
+		<name-credit>
+			<name>Macca</name>
+			<artist id="ba550d0e-adac-4864-b88b-407cab5e76af"><name>Paul McCartney</name></artist>
+		</name-credit>
+	</artist-credit>`
+
+	if err := xml.Unmarshal([]byte(data), &ac); err != nil {
+		t.Fatal(err)
+	}
+
+	want := ArtistCredit{
+		NameCredits: []NameCredit{
+			{
+				JoinPhrase: " & ",
+				Artist: Artist{
+					ID:   "4d5447d7-c61c-4120-ba1b-d7f471d385b9",
+					Name: "John Lennon",
+				},
+			},
+			{
+				Name: "Macca",
+				Artist: Artist{
+					ID:   "ba550d0e-adac-4864-b88b-407cab5e76af",
+					Name: "Paul McCartney",
+				},
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(ac, want) {
+		t.Error(requestDiff(&want, &ac))
+	}
+	if got := ac.String(); got != "John Lennon & Macca" {
+		t.Errorf("got %q, want %q", got, "John Lennon & Macca")
+	}
+}
+
+func TestAlias(t *testing.T) {
+
+	var a Alias
+	data := `<alias locale="ja" sort-name="Massive Attack" type="Artist name" primary="primary" begin-date="1991" end-date="1991-06">マッシヴ・アタック</alias>`
+
+	if err := xml.Unmarshal([]byte(data), &a); err != nil {
+		t.Fatal(err)
+	}
+
+	want := Alias{
+		Name:      "マッシヴ・アタック",
+		SortName:  "Massive Attack",
+		Locale:    "ja",
+		Type:      "Artist name",
+		Primary:   true,
+		BeginDate: mustPartialDate("1991"),
+		EndDate:   mustPartialDate("1991-06"),
+	}
+
+	if !reflect.DeepEqual(a, want) {
+		t.Error(requestDiff(&want, &a))
+	}
+}
+
+func TestDisambiguation(t *testing.T) {
+
+	entities := map[string]interface{}{
+		"area":          &Area{},
+		"artist":        &Artist{},
+		"event":         &Event{},
+		"instrument":    &Instrument{},
+		"label":         &Label{},
+		"place":         &Place{},
+		"recording":     &Recording{},
+		"release":       &Release{},
+		"release-group": &ReleaseGroup{},
+		"series":        &Series{},
+		"work":          &Work{},
+	}
+
+	for name, entity := range entities {
+		data := "<" + name + "><disambiguation>gopher</disambiguation></" + name + ">"
+		if err := xml.Unmarshal([]byte(data), entity); err != nil {
+			t.Errorf("%s: %v", name, err)
+			continue
+		}
+		got := reflect.ValueOf(entity).Elem().FieldByName("Disambiguation").String()
+		if got != "gopher" {
+			t.Errorf("%s: got disambiguation %q", name, got)
+		}
+	}
+}
+
+func TestMediumPregapAndDataTracks(t *testing.T) {
+
+	var m Medium
+	data := `<medium>
+		<position>1</position>
+		<format>Enhanced CD</format>
+		<disc-list count="1">
+			<disc id="I5l9cCSFccLKFEKS.7wqSZAorPU-">
+				<sectors>29522</sectors>
+				<offset-list count="2">
+					<offset position="1">150</offset>
+					<offset position="2">15555</offset>
+				</offset-list>
+			</disc>
+		</disc-list>
+		<pregap id="e5b4c45a-9d67-4b66-bbef-5f0cd5e5e0b4">
+			<position>0</position>
+			<number>0</number>
+			<length>60000</length>
+		</pregap>
+		<track-list count="1" offset="0">
+			<track id="0cf5b1e5-3b0c-4fd6-8a60-2a1f1e5d4b12">
+				<position>1</position>
+				<number>1</number>
+				<length>180000</length>
+			</track>
+		</track-list>
+		<data-track-list count="1">
+			<track id="65f0d5d9-4d8a-4cf5-9c83-0c8c0e6ad7e2">
+				<position>2</position>
+				<number>2</number>
+			</track>
+		</data-track-list>
+	</medium>`
+
+	if err := xml.Unmarshal([]byte(data), &m); err != nil {
+		t.Fatal(err)
+	}
+
+	want := Medium{
+		Format:   "Enhanced CD",
+		Position: 1,
+		Discs: []Disc{
+			{ID: "I5l9cCSFccLKFEKS.7wqSZAorPU-", Sectors: 29522, Offsets: []int{150, 15555}},
+		},
+		TrackCount: 1,
+		Tracks: []*Track{
+			{ID: "0cf5b1e5-3b0c-4fd6-8a60-2a1f1e5d4b12", Position: 1, Number: "1", Length: 180000},
+		},
+		Pregap: &Track{ID: "e5b4c45a-9d67-4b66-bbef-5f0cd5e5e0b4", Number: "0", Length: 60000},
+		DataTracks: []*Track{
+			{ID: "65f0d5d9-4d8a-4cf5-9c83-0c8c0e6ad7e2", Position: 2, Number: "2"},
+		},
+	}
+
+	if !reflect.DeepEqual(m, want) {
+		t.Error(requestDiff(&want, &m))
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+
+	entities := []struct {
+		file   string
+		entity MBLookupEntity
+		empty  MBLookupEntity
+	}{
+		{"LookupArtist.xml", &Artist{}, &Artist{}},
+		{"LookupRelease.xml", &Release{}, &Release{}},
+		{"LookupRecording.xml", &Recording{}, &Recording{}},
+		{"LookupWork.xml", &Work{}, &Work{}},
+		{"LookupPlace.xml", &Place{}, &Place{}},
+	}
+
+	for _, e := range entities {
+		data, err := ioutil.ReadFile(path.Join("testdata", e.file))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := xml.Unmarshal(data, e.entity.lookupResult()); err != nil {
+			t.Fatalf("%s: %v", e.file, err)
+		}
+
+		b, err := json.Marshal(e.entity)
+		if err != nil {
+			t.Errorf("%s: %v", e.file, err)
+			continue
+		}
+		if err := json.Unmarshal(b, e.empty); err != nil {
+			t.Errorf("%s: %v", e.file, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(e.empty, e.entity) {
+			t.Errorf("%s: %s", e.file, requestDiff(e.entity, e.empty))
+		}
+	}
+}
+
+func TestJSONFieldNames(t *testing.T) {
+
+	b, err := json.Marshal(&Artist{
+		ID:       "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
+		SortName: "Massive Attack",
+		Lifespan: Lifespan{
+			Begin: mustPartialDate("1987"),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"id":"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8","sortName":"Massive Attack","lifespan":{"begin":"1987","end":null},"area":{"lifespan":{"begin":null,"end":null}},"beginArea":{"lifespan":{"begin":null,"end":null}},"endArea":{"lifespan":{"begin":null,"end":null}},"rating":{}}`
+	if string(b) != want {
+		t.Errorf("got  %s\nwant %s", b, want)
+	}
+}
+
+func TestEntityString(t *testing.T) {
+
+	ac := ArtistCredit{NameCredits: []NameCredit{{Artist: Artist{Name: "Massive Attack"}}}}
+
+	tests := []struct {
+		entity fmt.Stringer
+		want   string
+	}{
+		{
+			&Artist{ID: "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8", Name: "Massive Attack", Type: ArtistTypeGroup, CountryCode: "GB"},
+			"Massive Attack [Group, GB] (MBID: 10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8)",
+		},
+		{
+			&Artist{Name: "Gopher"},
+			"Gopher",
+		},
+		{
+			&Release{
+				ID:           "07832b54-8266-47d5-bb0e-62c7f2cf5da5",
+				Title:        "Protection",
+				ArtistCredit: ac,
+				Date:         mustPartialDate("1995-01-24"),
+				CountryCode:  "US",
+			},
+			"Protection by Massive Attack [1995-01-24, US] (MBID: 07832b54-8266-47d5-bb0e-62c7f2cf5da5)",
+		},
+		{
+			&Recording{Title: "Protection", ArtistCredit: ac, Length: 471560},
+			"Protection by Massive Attack [7:52]",
+		},
+		{
+			&ReleaseGroup{Title: "Mezzanine", ArtistCredit: ac, PrimaryType: ReleaseGroupTypeAlbum},
+			"Mezzanine by Massive Attack [Album]",
+		},
+		{
+			&Work{Title: "Symphony no. 9", Type: WorkTypeSymphony},
+			"Symphony no. 9 [Symphony]",
+		},
+		{
+			&Place{Name: "Abbey Road Studios", Type: "Studio", Area: Area{Name: "London"}},
+			"Abbey Road Studios [Studio, London]",
+		},
+		{
+			&Event{Name: "Gopher Fest", Lifespan: Lifespan{Begin: mustPartialDate("2014-06")}},
+			"Gopher Fest [2014-06]",
+		},
+		{
+			&Label{Name: "Virgin", CountryCode: "GB"},
+			"Virgin [GB]",
+		},
+		{
+			&Area{Name: "Bristol", Type: AreaTypeCity},
+			"Bristol [City]",
+		},
+	}
+
+	for _, test := range tests {
+		if got := test.entity.String(); got != test.want {
+			t.Errorf("got %q, want %q", got, test.want)
+		}
+	}
+}
@@ -27,6 +27,14 @@ package gomusicbrainz
 
 // Tag is the common type for Tags.
 type Tag struct {
-	Count int    `xml:"count,attr"`
-	Name  string `xml:"name"`
+	Count int    `xml:"count,attr" json:"count,omitempty"`
+	Name  string `xml:"name" json:"name,omitempty"`
+}
+
+// Genre is a curated tag, e.g. "electronic". See
+// https://musicbrainz.org/doc/Genre
+type Genre struct {
+	ID    MBID   `xml:"id,attr" json:"id,omitempty"`
+	Count int    `xml:"count,attr" json:"count,omitempty"`
+	Name  string `xml:"name" json:"name,omitempty"`
 }
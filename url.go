@@ -0,0 +1,238 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"context"
+	"encoding/xml"
+	"net/url"
+)
+
+// URL represents a web resource together with its relationships to other
+// MusicBrainz entities. See https://musicbrainz.org/doc/URL
+type URL struct {
+	ID        MBID               `xml:"id,attr" json:"id,omitempty"`
+	Resource  string             `xml:"resource" json:"resource,omitempty"`
+	Relations TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
+}
+
+func (mbe *URL) lookupResult() interface{} {
+	var res struct {
+		XMLName xml.Name `xml:"metadata"`
+		Ptr     *URL     `xml:"url"`
+	}
+	res.Ptr = mbe
+	return &res
+}
+
+func (mbe *URL) apiEndpoint() string {
+	return "/url"
+}
+
+func (mbe *URL) Id() MBID {
+	return mbe.ID
+}
+
+// LookupURL performs an url lookup request for the given MBID.
+//
+// Possible inc params are <ENTITY>-rels e.g. artist-rels or release-rels.
+func (c *WS2Client) LookupURL(id MBID, inc ...IncludeOption) (*URL, error) {
+	return c.LookupURLContext(context.Background(), id, inc...)
+}
+
+// LookupURLContext is like LookupURL but aborts the request once ctx is done.
+func (c *WS2Client) LookupURLContext(ctx context.Context, id MBID, inc ...IncludeOption) (*URL, error) {
+	a := &URL{ID: id}
+	err := c.LookupContext(ctx, a, inc...)
+
+	return a, err
+}
+
+// LookupURLByResource performs an url lookup request for the given resource
+// e.g. "https://musicbrainz.org/". Possible inc params are the same as for
+// LookupURL.
+func (c *WS2Client) LookupURLByResource(resource string, inc ...IncludeOption) (*URL, error) {
+	return c.LookupURLByResourceContext(context.Background(), resource, inc...)
+}
+
+// LookupURLByResourceContext is like LookupURLByResource but aborts the request once ctx is done.
+func (c *WS2Client) LookupURLByResourceContext(ctx context.Context, resource string, inc ...IncludeOption) (*URL, error) {
+	a := &URL{}
+
+	params := encodeInc(inc)
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set("resource", resource)
+
+	err := c.getRequest(ctx, a.lookupResult(), params, a.apiEndpoint())
+
+	return a, err
+}
+
+// SearchURL queries MusicBrainz´ Search Server for URLs.
+//
+// Possible search fields to provide in searchTerm are:
+//
+//	relationtype  the type of any relationship related to this url
+//	targetid      the MBID of any entity related to this url
+//	targettype    the entity type of any entity related to this url
+//	uid           MBID of the url
+//	url           the url's resource value
+//	urlancestor   the url's resource value, including all parent paths
+//
+// With no fields specified searchTerm searches the url field only. For more
+// information visit
+// https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#URL
+func (c *WS2Client) SearchURL(searchTerm string, opts ...SearchOption) (*URLSearchResponse, error) {
+	return c.SearchURLContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchURLContext is like SearchURL but aborts the request once ctx is done.
+func (c *WS2Client) SearchURLContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*URLSearchResponse, error) {
+
+	result := urlListResult{}
+	err := c.searchRequest(ctx, "/url", &result, searchTerm, opts)
+
+	rsp := URLSearchResponse{}
+	rsp.WS2ListResponse = result.URLList.WS2ListResponse
+	rsp.Scores = make(ScoreMap)
+
+	for i, v := range result.URLList.URLs {
+		rsp.URLs = append(rsp.URLs, v.URL)
+		rsp.Scores[rsp.URLs[i]] = v.Score
+	}
+
+	return &rsp, err
+}
+
+// BrowseURLs performs a browse request for URLs linked to the given entity.
+// WS2 currently only supports browsing URLs by "resource", in which case id
+// is the resource e.g.
+//
+//	client.BrowseURLs("resource", "https://twitter.com/muse", "artist-rels")
+//
+// to find the entities linked to a web resource. Use LookupURLsByResource to
+// request several resources at once. Possible inc params are the same as for
+// LookupURL. Scores of the returned response are not populated since browse
+// requests are not ranked.
+func (c *WS2Client) BrowseURLs(entity string, id string, inc ...IncludeOption) (*URLSearchResponse, error) {
+	return c.BrowseURLsContext(context.Background(), entity, id, inc...)
+}
+
+// BrowseURLsContext is like BrowseURLs but aborts the request once ctx is done.
+func (c *WS2Client) BrowseURLsContext(ctx context.Context, entity string, id string, inc ...IncludeOption) (*URLSearchResponse, error) {
+
+	params := encodeInc(inc)
+	if params == nil {
+		params = url.Values{}
+	}
+	params.Set(entity, id)
+
+	return c.urlListRequest(ctx, params)
+}
+
+// LookupURLsByResource performs a request for the URLs with the given
+// resources e.g. "https://musicbrainz.org/". Possible inc params are the same
+// as for LookupURL. Scores of the returned response are not populated.
+func (c *WS2Client) LookupURLsByResource(resources []string, inc ...IncludeOption) (*URLSearchResponse, error) {
+	return c.LookupURLsByResourceContext(context.Background(), resources, inc...)
+}
+
+// LookupURLsByResourceContext is like LookupURLsByResource but aborts the request once ctx is done.
+func (c *WS2Client) LookupURLsByResourceContext(ctx context.Context, resources []string, inc ...IncludeOption) (*URLSearchResponse, error) {
+
+	params := encodeInc(inc)
+	if params == nil {
+		params = url.Values{}
+	}
+	params["resource"] = resources
+
+	return c.urlListRequest(ctx, params)
+}
+
+// urlListRequest requests the URLs matching params from the url endpoint.
+func (c *WS2Client) urlListRequest(ctx context.Context, params url.Values) (*URLSearchResponse, error) {
+
+	// WS2 returns a single url element if only one resource matches.
+	var result struct {
+		urlListResult
+		URL *URL `xml:"url"`
+	}
+	err := c.getRequest(ctx, &result, params, "/url")
+
+	rsp := URLSearchResponse{}
+	rsp.WS2ListResponse = result.URLList.WS2ListResponse
+
+	for _, v := range result.URLList.URLs {
+		rsp.URLs = append(rsp.URLs, v.URL)
+	}
+	if result.URL != nil {
+		rsp.Count = 1
+		rsp.URLs = append(rsp.URLs, result.URL)
+	}
+
+	return &rsp, err
+}
+
+// URLSearchResponse is the response type returned by the SearchURL,
+// BrowseURLs and LookupURLsByResource methods.
+type URLSearchResponse struct {
+	WS2ListResponse
+	URLs   []*URL   `json:"urls,omitempty"`
+	Scores ScoreMap `json:"-"`
+}
+
+// HasMore reports whether there are URLs after the ones in r.
+func (r *URLSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.URLs))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *URLSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.URLs))
+}
+
+// ResultsWithScore returns a slice of URLs with a min score.
+func (r *URLSearchResponse) ResultsWithScore(score int) []*URL {
+	var res []*URL
+	for _, v := range r.URLs {
+		if r.Scores[v] >= score {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+type urlListResult struct {
+	URLList struct {
+		WS2ListResponse
+		URLs []struct {
+			*URL
+			Score int `xml:"http://musicbrainz.org/ns/ext#-2.0 score,attr"`
+		} `xml:"url"`
+	} `xml:"url-list"`
+}
@@ -0,0 +1,218 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestSearchURL(t *testing.T) {
+
+	want := URLSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		URLs: []*URL{
+			{
+				ID:       "4347ec3b-2e74-4c5d-9bbd-1b2ee4856dd2",
+				Resource: "https://golang.org/",
+				Relations: TargetRelationsMap{
+					"artist": []Relation{
+						&ArtistRelation{
+							RelationAbstract: RelationAbstract{
+								TypeID:    "fe33d22f-c3b0-4d68-bd53-a856badf2b15",
+								Type:      "official homepage",
+								Target:    "some-artist-id",
+								Direction: "backward",
+							},
+							Artist: Artist{
+								ID:       "some-artist-id",
+								Name:     "Gopher And Friends",
+								SortName: "0Gopher And Friends",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/url", "SearchURL.xml", t)
+
+	returned, err := client.SearchURL(`url:"https://golang.org/"`)
+	if err != nil {
+		t.Error(err)
+	}
+
+	want.Scores = ScoreMap{
+		returned.URLs[0]: 100,
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupURLByResource(t *testing.T) {
+
+	want := URL{
+		ID:       "4347ec3b-2e74-4c5d-9bbd-1b2ee4856dd2",
+		Resource: "https://golang.org/",
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/url", func(w http.ResponseWriter, r *http.Request) {
+		if res := r.URL.Query().Get("resource"); res != "https://golang.org/" {
+			t.Errorf("unexpected resource param %q", res)
+		}
+		http.ServeFile(w, r, "./testdata/LookupURL.xml")
+	})
+
+	returned, err := client.LookupURLByResource("https://golang.org/")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupURLsByResource(t *testing.T) {
+
+	want := URLSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  2,
+			Offset: 0,
+		},
+		URLs: []*URL{
+			{
+				ID:       "4347ec3b-2e74-4c5d-9bbd-1b2ee4856dd2",
+				Resource: "https://golang.org/",
+			},
+			{
+				ID:       "c1a0f1a8-3a36-4f6b-9e3b-5f6a0a3c9fa4",
+				Resource: "https://musicbrainz.org/",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+
+	mux.HandleFunc("/url", func(w http.ResponseWriter, r *http.Request) {
+		res := r.URL.Query()["resource"]
+		if !reflect.DeepEqual(res, []string{"https://golang.org/", "https://musicbrainz.org/"}) {
+			t.Errorf("unexpected resource params %q", res)
+		}
+		http.ServeFile(w, r, "./testdata/LookupURLsByResource.xml")
+	})
+
+	returned, err := client.LookupURLsByResource([]string{
+		"https://golang.org/",
+		"https://musicbrainz.org/",
+	})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupURLsByResourceSingle(t *testing.T) {
+
+	want := URLSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		URLs: []*URL{
+			{
+				ID:       "4347ec3b-2e74-4c5d-9bbd-1b2ee4856dd2",
+				Resource: "https://golang.org/",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/url", "LookupURL.xml", t)
+
+	returned, err := client.LookupURLsByResource([]string{"https://golang.org/"})
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestBrowseURLs(t *testing.T) {
+
+	want := URLSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		URLs: []*URL{
+			{
+				ID:       "4347ec3b-2e74-4c5d-9bbd-1b2ee4856dd2",
+				Resource: "https://golang.org/",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/url", "LookupURL.xml", url.Values{
+		"resource": {"https://golang.org/"},
+		"inc":      {"artist-rels"},
+	}, t)
+
+	returned, err := client.BrowseURLs("resource", "https://golang.org/", "artist-rels")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
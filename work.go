@@ -25,15 +25,237 @@
 
 package gomusicbrainz
 
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+)
+
+// Work represents a distinct intellectual or artistic creation, e.g. a song
+// or a symphony. See https://musicbrainz.org/doc/Work
 type Work struct {
-	//TODO implement
+	ID             MBID               `xml:"id,attr" json:"id,omitempty"`
+	Type           WorkType           `xml:"type,attr" json:"type,omitempty"`
+	Title          string             `xml:"title" json:"title,omitempty"`
+	Disambiguation string             `xml:"disambiguation" json:"disambiguation,omitempty"`
+	Language       string             `xml:"language" json:"language,omitempty"`
+	Languages      []string           `xml:"language-list>language" json:"languages,omitempty"`
+	ISWC           ISWC               `xml:"iswc" json:"iswc,omitempty"`
+	ISWCs          []ISWC             `xml:"iswc-list>iswc" json:"iswcs,omitempty"`
+	Attributes     []WorkAttribute    `xml:"attribute-list>attribute" json:"attributes,omitempty"`
+	Aliases        []*Alias           `xml:"alias-list>alias" json:"aliases,omitempty"`
+	Tags           []Tag              `xml:"tag-list>tag" json:"tags,omitempty"`
+	Genres         []Genre            `xml:"genre-list>genre" json:"genres,omitempty"`
+	Rating         Rating             `xml:"rating" json:"rating,omitempty"`
+	UserRating     UserRating         `xml:"user-rating" json:"userRating,omitempty"`
+	Annotation     string             `xml:"annotation>text" json:"annotation,omitempty"`
+	Relations      TargetRelationsMap `xml:"relation-list" json:"relations,omitempty"`
+}
+
+// WorkType describes the form of a Work. The constants below cover the most
+// common types, see https://musicbrainz.org/doc/Work/Type for all of them.
+type WorkType string
+
+const (
+	WorkTypeAria            WorkType = "Aria"
+	WorkTypeBallet          WorkType = "Ballet"
+	WorkTypeCantata         WorkType = "Cantata"
+	WorkTypeConcerto        WorkType = "Concerto"
+	WorkTypeIncidentalMusic WorkType = "Incidental music"
+	WorkTypeMadrigal        WorkType = "Madrigal"
+	WorkTypeMass            WorkType = "Mass"
+	WorkTypeMotet           WorkType = "Motet"
+	WorkTypeMusical         WorkType = "Musical"
+	WorkTypeOpera           WorkType = "Opera"
+	WorkTypeOperetta        WorkType = "Operetta"
+	WorkTypeOratorio        WorkType = "Oratorio"
+	WorkTypeOverture        WorkType = "Overture"
+	WorkTypePartita         WorkType = "Partita"
+	WorkTypePoem            WorkType = "Poem"
+	WorkTypeQuartet         WorkType = "Quartet"
+	WorkTypeSonata          WorkType = "Sonata"
+	WorkTypeSong            WorkType = "Song"
+	WorkTypeSongCycle       WorkType = "Song-cycle"
+	WorkTypeSoundtrack      WorkType = "Soundtrack"
+	WorkTypeSuite           WorkType = "Suite"
+	WorkTypeSymphonicPoem   WorkType = "Symphonic poem"
+	WorkTypeSymphony        WorkType = "Symphony"
+	WorkTypeZarzuela        WorkType = "Zarzuela"
+	WorkTypeEtude           WorkType = "Étude"
+)
+
+// WorkAttribute is a typed attribute of a Work e.g. its key.
+type WorkAttribute struct {
+	Type   string `xml:"type,attr" json:"type,omitempty"`
+	TypeID MBID   `xml:"type-id,attr" json:"typeId,omitempty"`
+	Value  string `xml:",chardata" json:"value,omitempty"`
+}
+
+func (mbe *Work) lookupResult() interface{} {
+	var res struct {
+		XMLName xml.Name `xml:"metadata"`
+		Ptr     *Work    `xml:"work"`
+	}
+	res.Ptr = mbe
+	return &res
+}
+
+func (mbe *Work) apiEndpoint() string {
+	return "/work"
+}
+
+func (mbe *Work) Id() MBID {
+	return mbe.ID
+}
+
+// String returns the title, type, ISWC and MBID of the work.
+func (mbe *Work) String() string {
+	return entityString(mbe.Title, mbe.ID, string(mbe.Type), string(mbe.ISWC))
+}
+
+// LookupWork performs a work lookup request for the given MBID.
+//
+// Possible inc params are artists, aliases, tags, genres, ratings, annotation
+// and <ENTITY>-rels e.g. recording-rels, artist-rels or url-rels.
+func (c *WS2Client) LookupWork(id MBID, inc ...IncludeOption) (*Work, error) {
+	return c.LookupWorkContext(context.Background(), id, inc...)
+}
+
+// LookupWorkContext is like LookupWork but aborts the request once ctx is done.
+func (c *WS2Client) LookupWorkContext(ctx context.Context, id MBID, inc ...IncludeOption) (*Work, error) {
+	a := &Work{ID: id}
+	err := c.LookupContext(ctx, a, inc...)
+
+	return a, err
+}
+
+// SearchWork queries MusicBrainz´ Search Server for Works.
+//
+// Possible search fields to provide in searchTerm are:
+//
+//	alias       the aliases/misspellings for this work
+//	arid        artist id
+//	artist      artist name, an artist in the context of a work is an artist-work relation such as composer or lyricist
+//	comment     disambiguation comment
+//	iswc        ISWC of work
+//	lang        language of work
+//	tag         folksonomy tag
+//	type        work type
+//	wid         work id
+//	work        name of work
+//	workaccent  name of the work with any accent characters retained
+//
+// With no fields specified searchTerm searches the work and alias fields. For
+// more information visit
+// https://musicbrainz.org/doc/Development/XML_Web_Service/Version_2/Search#Work
+func (c *WS2Client) SearchWork(searchTerm string, opts ...SearchOption) (*WorkSearchResponse, error) {
+	return c.SearchWorkContext(context.Background(), searchTerm, opts...)
+}
+
+// SearchWorkContext is like SearchWork but aborts the request once ctx is done.
+func (c *WS2Client) SearchWorkContext(ctx context.Context, searchTerm string, opts ...SearchOption) (*WorkSearchResponse, error) {
+
+	result := workListResult{}
+	err := c.searchRequest(ctx, "/work", &result, searchTerm, opts)
+
+	rsp := WorkSearchResponse{}
+	rsp.WS2ListResponse = result.WorkList.WS2ListResponse
+	rsp.Scores = make(ScoreMap)
+
+	for i, v := range result.WorkList.Works {
+		rsp.Works = append(rsp.Works, v.Work)
+		rsp.Scores[rsp.Works[i]] = v.Score
+	}
+
+	return &rsp, err
+}
+
+// BrowseWorks performs a browse request for Works linked to the entity
+// with the given MBID. Possible linked entities are artist and recording e.g.
+//
+//	client.BrowseWorks("artist", "10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8", -1, -1)
+//
+// Possible inc params are the same as for LookupWork. Scores of the
+// returned response are not populated since browse requests are not ranked.
+func (c *WS2Client) BrowseWorks(entity string, id MBID, limit, offset int, inc ...IncludeOption) (*WorkSearchResponse, error) {
+	return c.BrowseWorksContext(context.Background(), entity, id, limit, offset, inc...)
+}
+
+// BrowseWorksContext is like BrowseWorks but aborts the request once ctx is done.
+func (c *WS2Client) BrowseWorksContext(ctx context.Context, entity string, id MBID, limit, offset int, inc ...IncludeOption) (*WorkSearchResponse, error) {
+
+	result := workListResult{}
+	err := c.browseRequest(ctx, "/work", &result, entity, id, nil, limit, offset, inc)
+
+	rsp := WorkSearchResponse{}
+	rsp.WS2ListResponse = result.WorkList.WS2ListResponse
+
+	for _, v := range result.WorkList.Works {
+		rsp.Works = append(rsp.Works, v.Work)
+	}
+
+	return &rsp, err
 }
 
-func (c *WS2Client) SearchWork(searchTerm string, limit, offset int) (*WorkSearchResponse, error) {
-	//TODO implement
-	return nil, nil
+// LookupByISWC performs a lookup request for all works associated with the
+// given ISWC. Multiple works can share one ISWC. Possible inc params are the
+// same as for LookupWork.
+func (c *WS2Client) LookupByISWC(iswc ISWC, inc ...IncludeOption) ([]*Work, error) {
+	return c.LookupByISWCContext(context.Background(), iswc, inc...)
 }
 
+// LookupByISWCContext is like LookupByISWC but aborts the request once ctx is done.
+func (c *WS2Client) LookupByISWCContext(ctx context.Context, iswc ISWC, inc ...IncludeOption) ([]*Work, error) {
+	// accept ISWCs with any separators, WS2 expects the canonical form
+	canonical, err := ParseISWC(string(iswc))
+	if err != nil {
+		return nil, fmt.Errorf("can't perform lookup with malformed ISWC %q.", iswc)
+	}
+
+	var result struct {
+		XMLName xml.Name `xml:"metadata"`
+		Works   []*Work  `xml:"work-list>work"`
+	}
+	err = c.getRequest(ctx, &result, encodeInc(inc), "/iswc/"+string(canonical))
+
+	return result.Works, err
+}
+
+// WorkSearchResponse is the response type returned by the SearchWork and
+// BrowseWorks methods.
 type WorkSearchResponse struct {
-	//TODO implement
+	WS2ListResponse
+	Works  []*Work  `json:"works,omitempty"`
+	Scores ScoreMap `json:"-"`
+}
+
+// HasMore reports whether there are works after the ones in r.
+func (r *WorkSearchResponse) HasMore() bool {
+	return r.hasMore(len(r.Works))
+}
+
+// NextOffset returns the offset to request the page following r with.
+func (r *WorkSearchResponse) NextOffset() int {
+	return r.nextOffset(len(r.Works))
+}
+
+// ResultsWithScore returns a slice of Works with a min score.
+func (r *WorkSearchResponse) ResultsWithScore(score int) []*Work {
+	var res []*Work
+	for _, v := range r.Works {
+		if r.Scores[v] >= score {
+			res = append(res, v)
+		}
+	}
+	return res
+}
+
+type workListResult struct {
+	WorkList struct {
+		WS2ListResponse
+		Works []struct {
+			*Work
+			Score int `xml:"http://musicbrainz.org/ns/ext#-2.0 score,attr"`
+		} `xml:"work"`
+	} `xml:"work-list"`
 }
@@ -0,0 +1,184 @@
+/*
+ * Copyright (c) 2014 Michael Wendland
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software and associated documentation files (the "Software"),
+ * to deal in the Software without restriction, including without limitation
+ * the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the
+ * Software is furnished to do so, subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in
+ * all copies or substantial portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+ * IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+ * FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+ * AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+ * LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING
+ * FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS
+ * IN THE SOFTWARE.
+ *
+ * 	Authors:
+ * 		Michael Wendland <michael@michiwend.com>
+ */
+
+package gomusicbrainz
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestSearchWork(t *testing.T) {
+
+	want := WorkSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		Works: []*Work{
+			{
+				ID:       "9ba1d2a4-a1e4-3b1b-8c0b-8a1bf1e2e6c2",
+				Type:     "Song",
+				Title:    "Teardrop",
+				Language: "eng",
+				ISWC:     "T-010.340.214-4",
+				ISWCs:    []ISWC{"T-010.340.214-4"},
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/work", "SearchWork.xml", t)
+
+	returned, err := client.SearchWork("Teardrop")
+	if err != nil {
+		t.Error(err)
+	}
+
+	want.Scores = ScoreMap{
+		returned.Works[0]: 100,
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupWork(t *testing.T) {
+
+	want := Work{
+		ID:             "1d1ba2a1-9b49-3b5e-a1d5-1f1c2d6a6c79",
+		Type:           WorkTypeSymphony,
+		Title:          "Symphony no. 9 in D minor, op. 125",
+		Disambiguation: "Choral",
+		Language:       "mul",
+		Languages:      []string{"deu", "zxx"},
+		Attributes: []WorkAttribute{
+			{
+				Type:   "Key",
+				TypeID: "7526c19d-3be4-3420-b6cc-9fb6e49fa1a9",
+				Value:  "D minor",
+			},
+		},
+		Aliases: []*Alias{
+			{
+				Name:     "Choral Symphony",
+				SortName: "Choral Symphony",
+				Type:     "Work name",
+			},
+		},
+		Annotation: `Also known as the "Choral" symphony.`,
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile(
+		"/work/1d1ba2a1-9b49-3b5e-a1d5-1f1c2d6a6c79",
+		"LookupWork.xml", t)
+
+	returned, err := client.LookupWork(
+		"1d1ba2a1-9b49-3b5e-a1d5-1f1c2d6a6c79",
+		"aliases",
+		"annotation")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestBrowseWorks(t *testing.T) {
+
+	want := WorkSearchResponse{
+		WS2ListResponse: WS2ListResponse{
+			Count:  1,
+			Offset: 0,
+		},
+		Works: []*Work{
+			{
+				ID:       "9ba1d2a4-a1e4-3b1b-8c0b-8a1bf1e2e6c2",
+				Type:     "Song",
+				Title:    "Teardrop",
+				Language: "eng",
+			},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFileWithParams("/work", "BrowseWorks.xml", url.Values{
+		"artist": {"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8"},
+	}, t)
+
+	returned, err := client.BrowseWorks(
+		"artist",
+		"10adbe5e-a2c0-4bf3-8249-2b4cbf6e6ca8",
+		-1, -1)
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(*returned, want) {
+		t.Error(requestDiff(&want, returned))
+	}
+}
+
+func TestLookupByISWC(t *testing.T) {
+
+	want := []*Work{
+		&Work{
+			ID:       "ca8a6dc1-c3b2-3cd8-9f2f-5e1b4c3f4f6b",
+			Type:     "Song",
+			Title:    "Protection",
+			Language: "eng",
+			ISWC:     "T-010.340.214-4",
+			ISWCs:    []ISWC{"T-010.340.214-4"},
+		},
+	}
+
+	setupHTTPTesting()
+	defer server.Close()
+	serveTestFile("/iswc/T-010.340.214-4", "LookupByISWC.xml", t)
+
+	returned, err := client.LookupByISWC("T0103402144")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !reflect.DeepEqual(returned, want) {
+		t.Error(requestDiff(&want, &returned))
+	}
+
+	if _, err := client.LookupByISWC("T-010"); err == nil {
+		t.Error("expected error for malformed ISWC")
+	}
+}